@@ -0,0 +1,83 @@
+// Package client holds AWSClient, the single object the provider stashes in
+// Terraform's `meta interface{}` slot. Every resource's CRUD funcs start by
+// type-asserting `meta.(*client.AWSClient)` to reach a configured per-service
+// SDK connection or one of the provider-level settings (account/partition/
+// region, default_tags, ignore_tags).
+package client
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+)
+
+// AWSClient is the provider's per-session client: one configured SDK
+// connection per service, plus the account/partition/region and tagging
+// configuration resources need to build ARNs and reconcile tags.
+type AWSClient struct {
+	AccountID string
+	Partition string
+	Region    string
+
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+
+	// session is retained so RDSConnForRegion can construct additional
+	// per-region RDS connections on demand for resources (e.g.
+	// aws_rds_cluster_endpoint) that manage Aurora Global Database
+	// secondary regions.
+	session *session.Session
+
+	rdsConnForRegionMu sync.Mutex
+	rdsConnForRegion   map[string]*rds.RDS
+
+	CloudWatchEventsConn *cloudwatchevents.CloudWatchEvents
+	EC2Conn              *ec2.EC2
+	EFSConn              *efs.EFS
+	ElasticBeanstalkConn *elasticbeanstalk.ElasticBeanstalk
+	EMRConn              *emr.EMR
+	LakeFormationConn    *lakeformation.LakeFormation
+	RDSConn              *rds.RDS
+	SchemasConn          *schemas.Schemas
+}
+
+// RegionalHostname builds a "<prefix>.<region>.amazonaws.com"-shaped hostname,
+// e.g. for an EFS mount target or any other service whose API doesn't return
+// its own regional DNS name.
+func (c *AWSClient) RegionalHostname(prefix string) string {
+	return fmt.Sprintf("%s.%s.amazonaws.com", prefix, c.Region)
+}
+
+// RDSConnForRegion returns an RDS connection for region, constructing and
+// caching it on first use. aws_rds_cluster_endpoint's secondary_region_endpoints
+// fan out to an Aurora Global Database's regional clusters, each of which must
+// be reached through a connection homed in its own region rather than RDSConn
+// (which is always homed in the provider's configured region).
+func (c *AWSClient) RDSConnForRegion(region string) *rds.RDS {
+	c.rdsConnForRegionMu.Lock()
+	defer c.rdsConnForRegionMu.Unlock()
+
+	if conn, ok := c.rdsConnForRegion[region]; ok {
+		return conn
+	}
+
+	if c.rdsConnForRegion == nil {
+		c.rdsConnForRegion = make(map[string]*rds.RDS)
+	}
+
+	conn := rds.New(c.session, aws.NewConfig().WithRegion(region))
+	c.rdsConnForRegion[region] = conn
+
+	return conn
+}
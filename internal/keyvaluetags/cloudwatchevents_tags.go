@@ -0,0 +1,80 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+)
+
+// CloudwatcheventsTags returns cloudwatchevents service tags from KeyValueTags.
+func (tags KeyValueTags) CloudwatcheventsTags() []*cloudwatchevents.Tag {
+	result := make([]*cloudwatchevents.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		tag := &cloudwatchevents.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CloudwatcheventsKeyValueTags creates KeyValueTags from cloudwatchevents service tags.
+func CloudwatcheventsKeyValueTags(tags []*cloudwatchevents.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// CloudwatcheventsListTags lists cloudwatchevents service tags and returns them as KeyValueTags.
+func CloudwatcheventsListTags(conn *cloudwatchevents.CloudWatchEvents, arn string) (KeyValueTags, error) {
+	input := &cloudwatchevents.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return CloudwatcheventsKeyValueTags(output.Tags), nil
+}
+
+// CloudwatcheventsUpdateTags updates cloudwatchevents service tags for a resource.
+func CloudwatcheventsUpdateTags(conn *cloudwatchevents.CloudWatchEvents, identifier string, oldTagsInterface, newTagsInterface interface{}) error {
+	oldTags := New(oldTagsInterface)
+	newTags := New(newTagsInterface)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &cloudwatchevents.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		if _, err := conn.UntagResource(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &cloudwatchevents.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.IgnoreAws().CloudwatcheventsTags(),
+		}
+
+		if _, err := conn.TagResource(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// Ec2Tags returns ec2 service tags from KeyValueTags.
+func (tags KeyValueTags) Ec2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		tag := &ec2.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Ec2KeyValueTags creates KeyValueTags from ec2 service tags.
+func Ec2KeyValueTags(tags []*ec2.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// Ec2UpdateTags updates ec2 service tags for a resource.
+func Ec2UpdateTags(conn *ec2.EC2, identifier string, oldTagsInterface, newTagsInterface interface{}) error {
+	oldTags := New(oldTagsInterface)
+	newTags := New(newTagsInterface)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &ec2.DeleteTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      removedTags.IgnoreAws().Ec2Tags(),
+		}
+
+		if _, err := conn.DeleteTags(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{identifier}),
+			Tags:      updatedTags.IgnoreAws().Ec2Tags(),
+		}
+
+		if _, err := conn.CreateTags(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
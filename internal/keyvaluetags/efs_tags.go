@@ -0,0 +1,17 @@
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+// EfsKeyValueTags creates KeyValueTags from efs service tags.
+func EfsKeyValueTags(tags []*efs.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
@@ -0,0 +1,216 @@
+// Package keyvaluetags provides a common representation of AWS resource tags
+// (KeyValueTags) along with the per-service List/Update/conversion helpers
+// that resources use to read and reconcile tags against the provider's
+// default_tags/ignore_tags provider-level configuration.
+//
+// The per-service helpers (e.g. CloudwatcheventsListTags, RdsUpdateTags,
+// SchemasKeyValueTags) are mechanical wrappers around that service's AWS SDK
+// tagging API/shape and live in their own <service>_tags.go file so that
+// adding support for a new service never touches this file.
+package keyvaluetags
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// KeyValueTags is a standardized in-memory representation of AWS resource
+// tags, independent of any single service's SDK tag shape ([]*service.Tag,
+// map[string]*string, etc). Values are pointers so a tag can be distinguished
+// from an explicitly empty-string value.
+type KeyValueTags map[string]*string
+
+// New creates KeyValueTags from common Terraform/AWS SDK Go types.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = aws.String(v)
+		}
+
+		return kvtm
+	case map[string]*string:
+		return KeyValueTags(value)
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = aws.String(v.(string))
+		}
+
+		return kvtm
+	}
+
+	return make(KeyValueTags)
+}
+
+// IgnoreAws returns non-system tags, excluding those with the "aws:" prefix
+// that AWS manages on the provider's behalf.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, "aws:") {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreConfig returns non-ignored tags as configured by a provider-level
+// ignore_tags block. A nil config ignores nothing.
+func (tags KeyValueTags) IgnoreConfig(ignoreConfig *IgnoreConfig) KeyValueTags {
+	if ignoreConfig == nil {
+		return tags.Clone()
+	}
+
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if ignoreConfig.KeyMatch(k) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// RemoveDefaultConfig returns tags not present in a provider-level
+// default_tags block, or whose value differs from the default. A nil config
+// removes nothing.
+func (tags KeyValueTags) RemoveDefaultConfig(defaultConfig *DefaultConfig) KeyValueTags {
+	if defaultConfig == nil {
+		return tags.Clone()
+	}
+
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if dv, ok := defaultConfig.Tags[k]; ok && aws.StringValue(dv) == aws.StringValue(v) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Clone returns a copy of tags that shares no backing map with the original.
+func (tags KeyValueTags) Clone() KeyValueTags {
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Map returns tags as a map[string]string, the shape Terraform's schema
+// expects for a TypeMap of TypeString.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		result[k] = aws.StringValue(v)
+	}
+
+	return result
+}
+
+// Keys returns tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Removed returns the tag keys present in tags but not in newTags, i.e. the
+// keys a diff-driven UpdateTags call should delete.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tag keys in newTags that are new or whose value
+// changed relative to tags, i.e. the keys a diff-driven UpdateTags call
+// should create or overwrite.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || aws.StringValue(oldV) != aws.StringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// DefaultConfig represents the provider-level default_tags configuration:
+// tags merged into every resource's tag set unless the resource overrides
+// the same key.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns tags with any DefaultConfig.Tags keys not already present
+// in tags merged in. A nil DefaultConfig merges nothing.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil {
+		return tags.Clone()
+	}
+
+	result := dc.Tags.Clone()
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreConfig represents the provider-level ignore_tags configuration: tag
+// keys and key prefixes that the provider should never attempt to manage.
+type IgnoreConfig struct {
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// KeyMatch returns true if key is an exact match in Keys or has a prefix in
+// KeyPrefixes.
+func (ic *IgnoreConfig) KeyMatch(key string) bool {
+	if ic == nil {
+		return false
+	}
+
+	if _, ok := ic.Keys[key]; ok {
+		return true
+	}
+
+	for prefix := range ic.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
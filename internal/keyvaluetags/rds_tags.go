@@ -0,0 +1,80 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// RdsTags returns rds service tags from KeyValueTags.
+func (tags KeyValueTags) RdsTags() []*rds.Tag {
+	result := make([]*rds.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		tag := &rds.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// RdsKeyValueTags creates KeyValueTags from rds service tags.
+func RdsKeyValueTags(tags []*rds.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// RdsListTags lists rds service tags and returns them as KeyValueTags.
+func RdsListTags(conn *rds.RDS, arn string) (KeyValueTags, error) {
+	input := &rds.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return RdsKeyValueTags(output.TagList), nil
+}
+
+// RdsUpdateTags updates rds service tags for a resource.
+func RdsUpdateTags(conn *rds.RDS, identifier string, oldTagsInterface, newTagsInterface interface{}) error {
+	oldTags := New(oldTagsInterface)
+	newTags := New(newTagsInterface)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		if _, err := conn.RemoveTagsFromResource(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &rds.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.IgnoreAws().RdsTags(),
+		}
+
+		if _, err := conn.AddTagsToResource(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
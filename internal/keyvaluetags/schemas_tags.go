@@ -0,0 +1,51 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+)
+
+// SchemasTags returns schemas service tags from KeyValueTags.
+//
+// Unlike most services, the schemas API represents tags as a plain
+// map[string]*string rather than a slice of Key/Value structs.
+func (tags KeyValueTags) SchemasTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// SchemasKeyValueTags creates KeyValueTags from schemas service tags.
+func SchemasKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// SchemasUpdateTags updates schemas service tags for a resource.
+func SchemasUpdateTags(conn *schemas.Schemas, arn string, oldTagsInterface, newTagsInterface interface{}) error {
+	oldTags := New(oldTagsInterface)
+	newTags := New(newTagsInterface)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &schemas.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		if _, err := conn.UntagResource(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", arn, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &schemas.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        updatedTags.IgnoreAws().SchemasTags(),
+		}
+
+		if _, err := conn.TagResource(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", arn, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EnvVar is the opt-in flag that switches a resource's Create/Update away from the plugin SDK's
+// usual CRUD entry points and onto the in-process Reconciler, for embedders that drive this
+// provider directly (no forked `terraform apply` per call).
+const EnvVar = "TF_AWS_INPROCESS_RECONCILE"
+
+// Enabled reports whether EnvVar is set, gating the reconciler path in resource Create/Update
+// funcs that support it.
+func Enabled() bool {
+	return os.Getenv(EnvVar) == "1"
+}
+
+// ApplyViaReconciler drives d's Create or Update through rec instead of the caller's normal CRUD
+// body. It builds the desired config from d's current attributes, reconciles it against rec's
+// cached prior state for d.Id() (generating one if this is a Create), and copies the resulting
+// attributes -- including Id -- back onto d.
+func ApplyViaReconciler(ctx context.Context, rec *Reconciler, d *schema.ResourceData, meta interface{}) error {
+	id := d.Id()
+	if id == "" {
+		id = resource.PrefixedUniqueId("reconcile-")
+	}
+
+	config := make(map[string]interface{}, len(rec.resource.Schema))
+	for k := range rec.resource.Schema {
+		config[k] = d.Get(k)
+	}
+
+	result, err := rec.Reconcile(ctx, id, config, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(result.Id())
+
+	for k := range rec.resource.Schema {
+		if err := d.Set(k, result.Get(k)); err != nil {
+			return fmt.Errorf("error setting %q after reconcile: %w", k, err)
+		}
+	}
+
+	return nil
+}
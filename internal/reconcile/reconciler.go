@@ -0,0 +1,98 @@
+// Package reconcile drives a *schema.Resource's CRUD through its Diff/Apply/RefreshWithoutUpgrade
+// methods against an in-memory terraform.InstanceState, instead of the separate Create/Read/
+// Update/Delete entry points a forked `terraform apply` normally calls once each. It exists so a
+// long-running embedder of this provider (a Crossplane-style controller reconcile loop, say) can
+// drive the same resource repeatedly without re-forking the provider binary per apply.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Reconciler wraps a *schema.Resource and the last observed terraform.InstanceState for each id
+// it has reconciled. It is safe for concurrent use.
+type Reconciler struct {
+	resource *schema.Resource
+
+	mu     sync.Mutex
+	states map[string]*terraform.InstanceState
+
+	// applying is 1 while Reconcile is inside rec.resource.Apply, so InProgress can tell the
+	// wrapped resource's own Create/Update funcs that they're being driven by Apply rather than by
+	// their normal entry point -- see InProgress.
+	applying int32
+}
+
+// NewReconciler wraps r. r's own Create/Read/Update/Delete funcs are still what ultimately runs --
+// Reconciler only changes how often and by what path they're invoked.
+func NewReconciler(r *schema.Resource) *Reconciler {
+	return &Reconciler{
+		resource: r,
+		states:   make(map[string]*terraform.InstanceState),
+	}
+}
+
+// Reconcile refreshes id's last observed state, diffs it against config, and applies the diff if
+// one exists. Calling Reconcile repeatedly with the same config for the same id is idempotent:
+// once the observed state matches config, Diff returns an empty diff and Apply is never called.
+func (rec *Reconciler) Reconcile(ctx context.Context, id string, config map[string]interface{}, meta interface{}) (*schema.ResourceData, error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	prior := rec.states[id]
+	if prior == nil {
+		prior = &terraform.InstanceState{}
+	}
+
+	refreshed, err := rec.resource.RefreshWithoutUpgrade(ctx, prior, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing prior state for %q: %w", id, err)
+	}
+	if refreshed == nil {
+		refreshed = &terraform.InstanceState{}
+	}
+
+	resourceConfig := terraform.NewResourceConfigRaw(config)
+
+	diff, err := rec.resource.Diff(ctx, refreshed, resourceConfig, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error diffing %q: %w", id, err)
+	}
+
+	newState := refreshed
+	if diff != nil && !diff.Empty() {
+		atomic.StoreInt32(&rec.applying, 1)
+		newState, err = rec.resource.Apply(ctx, refreshed, diff, meta)
+		atomic.StoreInt32(&rec.applying, 0)
+		if err != nil {
+			return nil, fmt.Errorf("error applying %q: %w", id, err)
+		}
+	}
+
+	rec.states[id] = newState
+
+	return rec.resource.Data(newState), nil
+}
+
+// InProgress reports whether this Reconciler is currently driving its wrapped resource's Apply
+// method. Apply synchronously calls back into the very Create/Update funcs that dispatch to
+// ApplyViaReconciler in the first place -- without this check, that second call would re-enter
+// Reconcile, which both recurses forever and deadlocks re-locking mu. A resource's Create/Update
+// should gate on `reconcile.Enabled() && !theReconciler.InProgress()`, so the re-entrant call falls
+// through to the resource's normal body instead of calling back into ApplyViaReconciler.
+func (rec *Reconciler) InProgress() bool {
+	return atomic.LoadInt32(&rec.applying) == 1
+}
+
+// Forget drops the cached prior state for id, e.g. once the embedder has deleted the resource.
+func (rec *Reconciler) Forget(id string) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	delete(rec.states, id)
+}
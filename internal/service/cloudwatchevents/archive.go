@@ -0,0 +1,272 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+func ResourceArchive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventArchiveCreate,
+		Read:   resourceAwsCloudWatchEventArchiveRead,
+		Update: resourceAwsCloudWatchEventArchiveUpdate,
+		Delete: resourceAwsCloudWatchEventArchiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 48),
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"event_pattern": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"retention_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"kms_key_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	name := d.Get("name").(string)
+	input := &events.CreateArchiveInput{
+		ArchiveName:    aws.String(name),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		input.EventPattern = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		input.RetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events archive: %s", input)
+	output, err := conn.CreateArchive(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Events archive (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCloudWatchEventArchiveStateEnabled(conn, name); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events archive (%s) to be enabled: %w", name, err)
+	}
+
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+	if len(t) > 0 {
+		if err := keyvaluetags.CloudwatcheventsUpdateTags(conn, aws.StringValue(output.ArchiveArn), nil, t.IgnoreAws()); err != nil {
+			return fmt.Errorf("error setting CloudWatch Events archive (%s) tags: %w", name, err)
+		}
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	output, err := conn.DescribeArchive(&events.DescribeArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events archive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", output.ArchiveName)
+	d.Set("arn", output.ArchiveArn)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("description", output.Description)
+	d.Set("retention_days", output.RetentionDays)
+	d.Set("state", output.State)
+
+	if output.EventPattern != nil {
+		pattern, err := structure.NormalizeJsonString(aws.StringValue(output.EventPattern))
+		if err != nil {
+			return fmt.Errorf("event_pattern contains an invalid JSON: %w", err)
+		}
+		d.Set("event_pattern", pattern)
+	}
+
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	archiveTags, err := keyvaluetags.CloudwatcheventsListTags(conn, aws.StringValue(output.ArchiveArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+	archiveTags = archiveTags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", archiveTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", archiveTags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	input := &events.UpdateArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		input.EventPattern = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		input.RetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		log.Printf("[DEBUG] Updating CloudWatch Events archive: %s", input)
+		_, err := conn.UpdateArchive(input)
+		if err != nil {
+			return fmt.Errorf("error updating CloudWatch Events archive (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitCloudWatchEventArchiveStateEnabled(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for CloudWatch Events archive (%s) to be enabled: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CloudwatcheventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating CloudWatch Events archive (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	log.Printf("[INFO] Deleting CloudWatch Events archive (%s)", d.Id())
+	_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events archive (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitCloudWatchEventArchiveStateEnabled(conn *events.CloudWatchEvents, name string) (*events.DescribeArchiveOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ArchiveStateCreating, events.ArchiveStateUpdating},
+		Target:  []string{events.ArchiveStateEnabled},
+		Refresh: cloudWatchEventArchiveRefreshFunc(conn, name),
+		Timeout: 5 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*events.DescribeArchiveOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func cloudWatchEventArchiveRefreshFunc(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeArchive(&events.DescribeArchiveInput{
+			ArchiveName: aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
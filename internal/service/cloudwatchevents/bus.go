@@ -1,18 +1,27 @@
 package cloudwatchevents
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/internal/client"
 	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/reconcile"
 	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tfresource"
 )
 
+// busReconciler is aws_cloudwatch_event_bus's entry point into the reconcile package -- see that
+// package's doc comment for why Create/Update below dispatch through it.
+var busReconciler = reconcile.NewReconciler(ResourceBus())
+
 func ResourceBus() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCloudWatchEventBusCreate,
@@ -49,6 +58,10 @@ func ResourceBus() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+	if reconcile.Enabled() && !busReconciler.InProgress() {
+		return reconcile.ApplyViaReconciler(context.Background(), busReconciler, d, meta)
+	}
+
 	conn := meta.(*client.AWSClient).CloudWatchEventsConn
 	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -124,6 +137,10 @@ func resourceAwsCloudWatchEventBusRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCloudWatchEventBusUpdate(d *schema.ResourceData, meta interface{}) error {
+	if reconcile.Enabled() && !busReconciler.InProgress() {
+		return reconcile.ApplyViaReconciler(context.Background(), busReconciler, d, meta)
+	}
+
 	conn := meta.(*client.AWSClient).CloudWatchEventsConn
 
 	arn := d.Get("arn").(string)
@@ -151,7 +168,29 @@ func resourceAwsCloudWatchEventBusDelete(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return fmt.Errorf("Error deleting CloudWatch Events event bus (%s): %w", d.Id(), err)
 	}
+
+	if _, err := tfresource.WaitUntil(context.Background(), tfresource.WaiterConfig{
+		Pending:    []string{"exists"},
+		Target:     []string{"destroyed"},
+		Refresh:    cloudWatchEventBusDeleteRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events event bus (%s) to be deleted: %w", d.Id(), err)
+	}
+
 	log.Printf("[INFO] CloudWatch Events event bus (%s) deleted", d.Id())
 
 	return nil
+}
+
+func cloudWatchEventBusDeleteRefreshFunc(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return tfresource.RefreshFuncFromAPI(func() (interface{}, error) {
+		return conn.DescribeEventBus(&events.DescribeEventBusInput{
+			Name: aws.String(name),
+		})
+	}, func(interface{}) string {
+		return "exists"
+	}, "destroyed", events.ErrCodeResourceNotFoundException)
 }
\ No newline at end of file
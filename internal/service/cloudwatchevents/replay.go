@@ -0,0 +1,236 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
+)
+
+func ResourceReplay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventReplayCreate,
+		Read:   resourceAwsCloudWatchEventReplayRead,
+		Delete: resourceAwsCloudWatchEventReplayDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"event_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"event_end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"filter_arns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventReplayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	name := d.Get("name").(string)
+	startTime, err := time.Parse(time.RFC3339, d.Get("event_start_time").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing event_start_time: %w", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, d.Get("event_end_time").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing event_end_time: %w", err)
+	}
+
+	input := &events.StartReplayInput{
+		ReplayName:     aws.String(name),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+		EventStartTime: aws.Time(startTime),
+		EventEndTime:   aws.Time(endTime),
+		Destination:    expandCloudWatchEventReplayDestination(d.Get("destination").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Starting CloudWatch Events replay: %s", input)
+	_, err = conn.StartReplay(input)
+	if err != nil {
+		return fmt.Errorf("error starting CloudWatch Events replay (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCloudWatchEventReplayStateCompleted(conn, name); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events replay (%s) to complete: %w", name, err)
+	}
+
+	return resourceAwsCloudWatchEventReplayRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventReplayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events replay (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events replay (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", output.ReplayName)
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("state", output.State)
+
+	if output.EventStartTime != nil {
+		d.Set("event_start_time", aws.TimeValue(output.EventStartTime).Format(time.RFC3339))
+	}
+	if output.EventEndTime != nil {
+		d.Set("event_end_time", aws.TimeValue(output.EventEndTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("destination", flattenCloudWatchEventReplayDestination(output.Destination)); err != nil {
+		return fmt.Errorf("error setting destination: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventReplayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).CloudWatchEventsConn
+
+	log.Printf("[INFO] Cancelling CloudWatch Events replay (%s)", d.Id())
+	_, err := conn.CancelReplay(&events.CancelReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeIllegalStatusException) {
+		// Already terminal (COMPLETED/CANCELLED/FAILED) -- nothing to cancel.
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling CloudWatch Events replay (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudWatchEventReplayDestination(l []interface{}) *events.ReplayDestination {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	dest := &events.ReplayDestination{
+		Arn: aws.String(m["arn"].(string)),
+	}
+
+	if v, ok := m["filter_arns"].([]interface{}); ok && len(v) > 0 {
+		dest.FilterArns = flex.ExpandStringList(v)
+	}
+
+	return dest
+}
+
+func flattenCloudWatchEventReplayDestination(dest *events.ReplayDestination) []interface{} {
+	if dest == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"arn":         aws.StringValue(dest.Arn),
+			"filter_arns": flex.FlattenStringList(dest.FilterArns),
+		},
+	}
+}
+
+func waitCloudWatchEventReplayStateCompleted(conn *events.CloudWatchEvents, name string) (*events.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ReplayStateStarting, events.ReplayStateRunning},
+		Target:  []string{events.ReplayStateCompleted},
+		Refresh: cloudWatchEventReplayRefreshFunc(conn, name),
+		Timeout: 30 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*events.DescribeReplayOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func cloudWatchEventReplayRefreshFunc(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeReplay(&events.DescribeReplayInput{
+			ReplayName: aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
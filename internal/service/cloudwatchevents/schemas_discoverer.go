@@ -0,0 +1,169 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+// ResourceSchemasDiscoverer manages EventBridge schema discovery against an event bus,
+// publishing discovered schemas into the account's default registry.
+func ResourceSchemasDiscoverer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSchemasDiscovererCreate,
+		Read:   resourceAwsSchemasDiscovererRead,
+		Update: resourceAwsSchemasDiscovererUpdate,
+		Delete: resourceAwsSchemasDiscovererDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"discoverer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsSchemasDiscovererCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	sourceArn := d.Get("source_arn").(string)
+	input := &schemas.CreateDiscovererInput{
+		SourceArn: aws.String(sourceArn),
+		Tags:      t.IgnoreAws().SchemasTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Schemas discoverer: %s", input)
+	output, err := conn.CreateDiscoverer(input)
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Schemas discoverer (%s): %w", sourceArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.DiscovererId))
+
+	return resourceAwsSchemasDiscovererRead(d, meta)
+}
+
+func resourceAwsSchemasDiscovererRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeDiscoverer(&schemas.DescribeDiscovererInput{
+		DiscovererId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		log.Printf("[WARN] EventBridge Schemas discoverer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Schemas discoverer (%s): %w", d.Id(), err)
+	}
+
+	d.Set("discoverer_id", output.DiscovererId)
+	d.Set("source_arn", output.SourceArn)
+	d.Set("description", output.Description)
+	d.Set("arn", output.DiscovererArn)
+	d.Set("state", output.State)
+
+	discovererTags := keyvaluetags.SchemasKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", discovererTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", discovererTags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSchemasDiscovererUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	if d.HasChange("description") {
+		input := &schemas.UpdateDiscovererInput{
+			DiscovererId: aws.String(d.Id()),
+			Description:  aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Schemas discoverer: %s", input)
+		if _, err := conn.UpdateDiscoverer(input); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas discoverer (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SchemasUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas discoverer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSchemasDiscovererRead(d, meta)
+}
+
+func resourceAwsSchemasDiscovererDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	log.Printf("[INFO] Deleting EventBridge Schemas discoverer (%s)", d.Id())
+	_, err := conn.DeleteDiscoverer(&schemas.DeleteDiscovererInput{
+		DiscovererId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Schemas discoverer (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
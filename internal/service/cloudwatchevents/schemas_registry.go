@@ -0,0 +1,159 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+// ResourceSchemasRegistry manages an EventBridge Schemas registry, the namespace that
+// aws_schemas_schema and aws_schemas_discoverer schemas are grouped under.
+func ResourceSchemasRegistry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSchemasRegistryCreate,
+		Read:   resourceAwsSchemasRegistryRead,
+		Update: resourceAwsSchemasRegistryUpdate,
+		Delete: resourceAwsSchemasRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsSchemasRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &schemas.CreateRegistryInput{
+		RegistryName: aws.String(name),
+		Tags:         t.IgnoreAws().SchemasTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Schemas registry: %s", input)
+	_, err := conn.CreateRegistry(input)
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Schemas registry (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSchemasRegistryRead(d, meta)
+}
+
+func resourceAwsSchemasRegistryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeRegistry(&schemas.DescribeRegistryInput{
+		RegistryName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		log.Printf("[WARN] EventBridge Schemas registry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Schemas registry (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", output.RegistryName)
+	d.Set("description", output.Description)
+	d.Set("arn", output.RegistryArn)
+
+	registryTags := keyvaluetags.SchemasKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", registryTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", registryTags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSchemasRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	if d.HasChange("description") {
+		input := &schemas.UpdateRegistryInput{
+			RegistryName: aws.String(d.Id()),
+			Description:  aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Schemas registry: %s", input)
+		if _, err := conn.UpdateRegistry(input); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas registry (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SchemasUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas registry (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSchemasRegistryRead(d, meta)
+}
+
+func resourceAwsSchemasRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	log.Printf("[INFO] Deleting EventBridge Schemas registry (%s)", d.Id())
+	_, err := conn.DeleteRegistry(&schemas.DeleteRegistryInput{
+		RegistryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Schemas registry (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+func ResourceSchemasSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSchemasSchemaCreate,
+		Read:   resourceAwsSchemasSchemaRead,
+		Update: resourceAwsSchemasSchemaUpdate,
+		Delete: resourceAwsSchemasSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSchemasSchemaImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 385),
+			},
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					schemas.TypeOpenApi3,
+					schemas.TypeJSONSchemaDraft4,
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsSchemasSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	registryName := d.Get("registry_name").(string)
+
+	input := &schemas.CreateSchemaInput{
+		SchemaName:   aws.String(name),
+		RegistryName: aws.String(registryName),
+		Type:         aws.String(d.Get("type").(string)),
+		Content:      aws.String(d.Get("content").(string)),
+		Tags:         t.IgnoreAws().SchemasTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Schemas schema: %s", input)
+	_, err := conn.CreateSchema(input)
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge Schemas schema (%s): %w", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", registryName, name))
+
+	return resourceAwsSchemasSchemaRead(d, meta)
+}
+
+func resourceAwsSchemasSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	registryName, name, err := decodeSchemasSchemaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeSchema(&schemas.DescribeSchemaInput{
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(name),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		log.Printf("[WARN] EventBridge Schemas schema (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Schemas schema (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", name)
+	d.Set("registry_name", registryName)
+	d.Set("type", output.Type)
+	d.Set("description", output.Description)
+	d.Set("arn", output.SchemaArn)
+	d.Set("version", output.SchemaVersion)
+
+	if output.VersionCreatedDate != nil {
+		d.Set("version_created_date", aws.TimeValue(output.VersionCreatedDate).String())
+	}
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(output.LastModified).String())
+	}
+
+	if output.Content != nil {
+		content, err := structure.NormalizeJsonString(aws.StringValue(output.Content))
+		if err != nil {
+			return fmt.Errorf("content contains an invalid JSON: %w", err)
+		}
+		d.Set("content", content)
+	}
+
+	schemaTags := keyvaluetags.SchemasKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", schemaTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", schemaTags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSchemasSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	registryName, name, err := decodeSchemasSchemaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("content", "description", "type") {
+		input := &schemas.UpdateSchemaInput{
+			RegistryName: aws.String(registryName),
+			SchemaName:   aws.String(name),
+			Content:      aws.String(d.Get("content").(string)),
+			Type:         aws.String(d.Get("type").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Schemas schema: %s", input)
+		if _, err := conn.UpdateSchema(input); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas schema (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SchemasUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EventBridge Schemas schema (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSchemasSchemaRead(d, meta)
+}
+
+func resourceAwsSchemasSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).SchemasConn
+
+	registryName, name, err := decodeSchemasSchemaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EventBridge Schemas schema (%s)", d.Id())
+	_, err = conn.DeleteSchema(&schemas.DeleteSchemaInput{
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, schemas.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EventBridge Schemas schema (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSchemasSchemaImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	registryName, name, err := decodeSchemasSchemaID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("registry_name", registryName)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodeSchemasSchemaID splits the `registry_name/schema_name` composite ID used by
+// aws_schemas_schema for import, mirroring the request's registry_name/schema_name convention.
+func decodeSchemasSchemaID(id string) (registryName string, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%s), expected registry_name/schema_name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,7 +29,7 @@ func ResourceRouteTableAssociation() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"subnet_id", "gateway_id"},
+				ExactlyOneOf: []string{"subnet_id", "gateway_id", "vpc_id", "subnet_ids", "gateway_ids"},
 			},
 
 			"route_table_id": {
@@ -40,7 +41,42 @@ func ResourceRouteTableAssociation() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"subnet_id", "gateway_id"},
+				ExactlyOneOf: []string{"subnet_id", "gateway_id", "vpc_id", "subnet_ids", "gateway_ids"},
+			},
+
+			// vpc_id manages the VPC's *main* route table association -- setting it points the
+			// VPC's default association at route_table_id, remembering the VPC's original main
+			// route table so it can be restored on delete.
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id", "gateway_id", "vpc_id", "subnet_ids", "gateway_ids"},
+			},
+
+			"original_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// subnet_ids/gateway_ids track a whole set of individual AWS route table associations
+			// as one Terraform resource, so large VPCs don't need one aws_route_table_association
+			// per subnet. Unlike subnet_id/gateway_id this set can grow and shrink in place: Update
+			// diffs it and associates/disassociates only the changed members.
+			"subnet_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"subnet_id", "gateway_id", "vpc_id", "subnet_ids", "gateway_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+			},
+
+			"gateway_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"subnet_id", "gateway_id", "vpc_id", "subnet_ids", "gateway_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
 			},
 		},
 	}
@@ -49,6 +85,18 @@ func ResourceRouteTableAssociation() *schema.Resource {
 func resourceAwsRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*client.AWSClient).EC2Conn
 
+	if v, ok := d.GetOk("vpc_id"); ok {
+		return resourceAwsRouteTableAssociationCreateMain(d, meta, v.(string))
+	}
+
+	if v := d.Get("subnet_ids").(*schema.Set); v.Len() > 0 {
+		return resourceAwsRouteTableAssociationCreateBulk(d, meta, "subnet_id", v)
+	}
+
+	if v := d.Get("gateway_ids").(*schema.Set); v.Len() > 0 {
+		return resourceAwsRouteTableAssociationCreateBulk(d, meta, "gateway_id", v)
+	}
+
 	routeTableID := d.Get("route_table_id").(string)
 	input := &ec2.AssociateRouteTableInput{
 		RouteTableId: aws.String(routeTableID),
@@ -85,9 +133,71 @@ func resourceAwsRouteTableAssociationCreate(d *schema.ResourceData, meta interfa
 	return resourceAwsRouteTableAssociationRead(d, meta)
 }
 
+// resourceAwsRouteTableAssociationCreateMain replaces vpcID's current main route table
+// association with route_table_id, remembering the VPC's original main route table ID in state so
+// Delete can restore it -- the behavior previously unique to aws_main_route_table_association,
+// folded into this resource type via vpc_id.
+func resourceAwsRouteTableAssociationCreateMain(d *schema.ResourceData, meta interface{}, vpcID string) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	mainAssociation, err := findMainRouteTableAssociationByVpcID(conn, vpcID)
+	if err != nil {
+		return fmt.Errorf("error finding main Route Table Association for VPC (%s): %w", vpcID, err)
+	}
+
+	d.Set("original_route_table_id", mainAssociation.RouteTableId)
+
+	input := &ec2.ReplaceRouteTableAssociationInput{
+		AssociationId: mainAssociation.RouteTableAssociationId,
+		RouteTableId:  aws.String(d.Get("route_table_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating main Route Table Association: %s", input)
+	output, err := conn.ReplaceRouteTableAssociation(input)
+	if err != nil {
+		return fmt.Errorf("error creating main Route Table Association for VPC (%s): %w", vpcID, err)
+	}
+
+	d.SetId(aws.StringValue(output.NewAssociationId))
+
+	log.Printf("[DEBUG] Waiting for Route Table Association (%s) creation", d.Id())
+	if _, err := waitRouteTableAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Route Table Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsRouteTableAssociationRead(d, meta)
+}
+
+// resourceAwsRouteTableAssociationCreateBulk associates every element of targets with
+// route_table_id individually, tracking the whole group as one Terraform resource via a composite
+// ID (route-table-id/assoc-id1,assoc-id2,...) instead of one aws_route_table_association per
+// subnet or gateway, which avoids state explosion in large VPCs.
+func resourceAwsRouteTableAssociationCreateBulk(d *schema.ResourceData, meta interface{}, targetField string, targets *schema.Set) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+	routeTableID := d.Get("route_table_id").(string)
+
+	associationIDs := make([]string, 0, targets.Len())
+	for _, target := range targets.List() {
+		associationID, err := associateRouteTableAssociationBulkTarget(conn, routeTableID, targetField, target.(string))
+		if err != nil {
+			return err
+		}
+
+		associationIDs = append(associationIDs, associationID)
+	}
+
+	d.SetId(buildRouteTableAssociationBulkID(routeTableID, associationIDs))
+
+	return resourceAwsRouteTableAssociationRead(d, meta)
+}
+
 func resourceAwsRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*client.AWSClient).EC2Conn
 
+	if strings.Contains(d.Id(), routeTableAssociationBulkIDSeparator) {
+		return resourceAwsRouteTableAssociationReadBulk(d, meta)
+	}
+
 	association, err := findRouteTableAssociationByID(conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -107,9 +217,64 @@ func resourceAwsRouteTableAssociationRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceAwsRouteTableAssociationReadBulk re-describes every association ID tracked in d's
+// composite ID, dropping any AWS has already forgotten about (rebuilding the ID around whatever's
+// left) and removing the resource entirely once none remain.
+func resourceAwsRouteTableAssociationReadBulk(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	routeTableID, associationIDs, err := parseRouteTableAssociationBulkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subnetIDs := schema.NewSet(schema.HashString, nil)
+	gatewayIDs := schema.NewSet(schema.HashString, nil)
+	liveAssociationIDs := make([]string, 0, len(associationIDs))
+
+	for _, associationID := range associationIDs {
+		association, err := findRouteTableAssociationByID(conn, associationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Route Table Association (%s): %w", associationID, err)
+		}
+
+		liveAssociationIDs = append(liveAssociationIDs, associationID)
+
+		if v := aws.StringValue(association.SubnetId); v != "" {
+			subnetIDs.Add(v)
+		}
+
+		if v := aws.StringValue(association.GatewayId); v != "" {
+			gatewayIDs.Add(v)
+		}
+	}
+
+	if len(liveAssociationIDs) == 0 {
+		log.Printf("[WARN] Route Table Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(buildRouteTableAssociationBulkID(routeTableID, liveAssociationIDs))
+	d.Set("route_table_id", routeTableID)
+	d.Set("subnet_ids", subnetIDs)
+	d.Set("gateway_ids", gatewayIDs)
+
+	return nil
+}
+
 func resourceAwsRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*client.AWSClient).EC2Conn
 
+	if strings.Contains(d.Id(), routeTableAssociationBulkIDSeparator) {
+		return resourceAwsRouteTableAssociationUpdateBulk(d, meta)
+	}
+
 	input := &ec2.ReplaceRouteTableAssociationInput{
 		AssociationId: aws.String(d.Id()),
 		RouteTableId:  aws.String(d.Get("route_table_id").(string)),
@@ -130,8 +295,9 @@ func resourceAwsRouteTableAssociationUpdate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("error updating Route Table Association (%s): %w", d.Id(), err)
 	}
 
-	// I don't think we'll ever reach this code for a subnet/gateway route table association.
-	// It would only come in to play for a VPC main route table association.
+	// subnet_id/gateway_id/vpc_id are ForceNew but route_table_id is not, so this is reached for
+	// any singular association whose route_table_id changes: the existing association (a subnet's,
+	// a gateway's, or the VPC's main one) is re-pointed at the new table.
 
 	d.SetId(aws.StringValue(output.NewAssociationId))
 
@@ -143,12 +309,94 @@ func resourceAwsRouteTableAssociationUpdate(d *schema.ResourceData, meta interfa
 	return resourceAwsRouteTableAssociationRead(d, meta)
 }
 
+// resourceAwsRouteTableAssociationUpdateBulk diffs subnet_ids and gateway_ids against their prior
+// values, associating newly added targets and disassociating removed ones -- unlike the singular
+// subnet_id/gateway_id arguments, which are ForceNew, the whole group is never destroyed and
+// recreated just because one member changed.
+func resourceAwsRouteTableAssociationUpdateBulk(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	routeTableID, liveAssociationIDs, err := parseRouteTableAssociationBulkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	liveAssociationIDs, err = reconcileRouteTableAssociationBulkTargets(conn, routeTableID, liveAssociationIDs, "subnet_ids", "subnet_id", d)
+	if err != nil {
+		return err
+	}
+
+	liveAssociationIDs, err = reconcileRouteTableAssociationBulkTargets(conn, routeTableID, liveAssociationIDs, "gateway_ids", "gateway_id", d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildRouteTableAssociationBulkID(routeTableID, liveAssociationIDs))
+
+	return resourceAwsRouteTableAssociationRead(d, meta)
+}
+
 func resourceAwsRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*client.AWSClient).EC2Conn
 
+	if _, ok := d.GetOk("vpc_id"); ok {
+		return resourceAwsRouteTableAssociationDeleteMain(d, meta)
+	}
+
+	if strings.Contains(d.Id(), routeTableAssociationBulkIDSeparator) {
+		return resourceAwsRouteTableAssociationDeleteBulk(d, meta)
+	}
+
 	return ec2RouteTableAssociationDelete(conn, d.Id())
 }
 
+// resourceAwsRouteTableAssociationDeleteBulk disassociates every association ID tracked in d's
+// composite ID.
+func resourceAwsRouteTableAssociationDeleteBulk(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	_, associationIDs, err := parseRouteTableAssociationBulkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, associationID := range associationIDs {
+		if err := ec2RouteTableAssociationDelete(conn, associationID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsRouteTableAssociationDeleteMain points the VPC's main route table association back
+// at original_route_table_id, the main route table that was in effect before Create -- a vpc_id
+// association has nothing to disassociate; a VPC always has exactly one main association.
+func resourceAwsRouteTableAssociationDeleteMain(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	originalRouteTableID := d.Get("original_route_table_id").(string)
+	if originalRouteTableID == "" {
+		return fmt.Errorf("cannot restore main Route Table Association: original_route_table_id is unknown")
+	}
+
+	log.Printf("[DEBUG] Restoring main Route Table Association (%s) to original Route Table (%s)", d.Id(), originalRouteTableID)
+	_, err := conn.ReplaceRouteTableAssociation(&ec2.ReplaceRouteTableAssociationInput{
+		AssociationId: aws.String(d.Id()),
+		RouteTableId:  aws.String(originalRouteTableID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAssociationIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error restoring main Route Table Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
@@ -217,4 +465,188 @@ func ec2RouteTableAssociationDelete(conn *ec2.EC2, associationID string) error {
 	}
 
 	return nil
+}
+
+// routeTableAssociationBulkIDSeparator separates a bulk (subnet_ids/gateway_ids) resource's
+// route_table_id from its comma-joined list of individual association IDs in its composite ID.
+// Singular subnet_id/gateway_id/vpc_id associations use AWS's own association ID verbatim, which
+// never contains this separator, so its presence is also how Read/Update/Delete tell bulk IDs
+// apart from singular ones.
+const routeTableAssociationBulkIDSeparator = "/"
+
+// buildRouteTableAssociationBulkID joins routeTableID and associationIDs into a bulk resource's
+// composite ID, sorting associationIDs first so the ID is stable across reads regardless of
+// AWS's (or this resource's) iteration order.
+func buildRouteTableAssociationBulkID(routeTableID string, associationIDs []string) string {
+	sorted := append([]string(nil), associationIDs...)
+	sort.Strings(sorted)
+
+	return routeTableID + routeTableAssociationBulkIDSeparator + strings.Join(sorted, ",")
+}
+
+// parseRouteTableAssociationBulkID splits a bulk resource's composite ID back into its route
+// table ID and association IDs.
+func parseRouteTableAssociationBulkID(id string) (string, []string, error) {
+	parts := strings.SplitN(id, routeTableAssociationBulkIDSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("unexpected format for Route Table Association ID (%s), expected route-table-id%sassoc-id1,assoc-id2,...", id, routeTableAssociationBulkIDSeparator)
+	}
+
+	return parts[0], strings.Split(parts[1], ","), nil
+}
+
+// associateRouteTableAssociationBulkTarget associates a single subnet or gateway with
+// routeTableID, using the same retry-on-InvalidRouteTableID.NotFound logic as the singular
+// subnet_id/gateway_id create path, and returns the resulting association ID once it's available.
+func associateRouteTableAssociationBulkTarget(conn *ec2.EC2, routeTableID, targetField, targetID string) (string, error) {
+	input := &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(routeTableID),
+	}
+
+	switch targetField {
+	case "subnet_id":
+		input.SubnetId = aws.String(targetID)
+	case "gateway_id":
+		input.GatewayId = aws.String(targetID)
+	}
+
+	log.Printf("[DEBUG] Creating Route Table Association: %s", input)
+	output, err := tfresource.RetryWhenAwsErrCodeEquals(
+		routeTableAssociationPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.AssociateRouteTable(input)
+		},
+		errCodeInvalidRouteTableIDNotFound,
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("error creating Route Table (%s) Association: %w", routeTableID, err)
+	}
+
+	associationID := aws.StringValue(output.(*ec2.AssociateRouteTableOutput).AssociationId)
+
+	log.Printf("[DEBUG] Waiting for Route Table Association (%s) creation", associationID)
+	if _, err := waitRouteTableAssociationCreated(conn, associationID); err != nil {
+		return "", fmt.Errorf("error waiting for Route Table Association (%s) create: %w", associationID, err)
+	}
+
+	return associationID, nil
+}
+
+// reconcileRouteTableAssociationBulkTargets diffs d's schemaKey (subnet_ids or gateway_ids)
+// against its prior value, disassociating removed targets and associating added ones, and returns
+// liveAssociationIDs updated to reflect the result.
+func reconcileRouteTableAssociationBulkTargets(conn *ec2.EC2, routeTableID string, liveAssociationIDs []string, schemaKey, targetField string, d *schema.ResourceData) ([]string, error) {
+	if !d.HasChange(schemaKey) {
+		return liveAssociationIDs, nil
+	}
+
+	o, n := d.GetChange(schemaKey)
+	oldTargets := o.(*schema.Set)
+	newTargets := n.(*schema.Set)
+
+	for _, target := range oldTargets.Difference(newTargets).List() {
+		associationID, remaining, err := popRouteTableAssociationBulkTarget(conn, liveAssociationIDs, targetField, target.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		liveAssociationIDs = remaining
+
+		if associationID == "" {
+			continue
+		}
+
+		if err := ec2RouteTableAssociationDelete(conn, associationID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, target := range newTargets.Difference(oldTargets).List() {
+		associationID, err := associateRouteTableAssociationBulkTarget(conn, routeTableID, targetField, target.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		liveAssociationIDs = append(liveAssociationIDs, associationID)
+	}
+
+	return liveAssociationIDs, nil
+}
+
+// popRouteTableAssociationBulkTarget finds the association ID among associationIDs whose
+// targetField matches targetID, returning it along with associationIDs with that entry removed.
+// It returns an empty associationID, with associationIDs unchanged, if no match is found -- AWS
+// has presumably already forgotten the association, so there's nothing left to disassociate.
+func popRouteTableAssociationBulkTarget(conn *ec2.EC2, associationIDs []string, targetField, targetID string) (string, []string, error) {
+	for i, associationID := range associationIDs {
+		association, err := findRouteTableAssociationByID(conn, associationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return "", associationIDs, fmt.Errorf("error reading Route Table Association (%s): %w", associationID, err)
+		}
+
+		var current string
+		switch targetField {
+		case "subnet_id":
+			current = aws.StringValue(association.SubnetId)
+		case "gateway_id":
+			current = aws.StringValue(association.GatewayId)
+		}
+
+		if current == targetID {
+			remaining := append(append([]string(nil), associationIDs[:i]...), associationIDs[i+1:]...)
+			return associationID, remaining, nil
+		}
+	}
+
+	return "", associationIDs, nil
+}
+
+// findMainRouteTableAssociationByVpcID returns vpcID's current main route table association.
+func findMainRouteTableAssociationByVpcID(conn *ec2.EC2, vpcID string) (*ec2.RouteTableAssociation, error) {
+	routeTable, err := findMainRouteTableByVpcID(conn, vpcID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			return association, nil
+		}
+	}
+
+	return nil, tfresource.NewNotFoundError(fmt.Errorf("no main association found on Route Table (%s)", aws.StringValue(routeTable.RouteTableId)), routeTable)
+}
+
+// findMainRouteTableByVpcID returns vpcID's main route table, the one every subnet implicitly
+// routes through until explicitly associated with another route table.
+func findMainRouteTableByVpcID(conn *ec2.EC2, vpcID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("association.main"),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 {
+		return nil, tfresource.NewNotFoundError(err, input)
+	}
+
+	return output.RouteTables[0], nil
 }
\ No newline at end of file
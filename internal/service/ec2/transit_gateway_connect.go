@@ -0,0 +1,254 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+func ResourceTransitGatewayConnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayConnectCreate,
+		Read:   resourceAwsEc2TransitGatewayConnectRead,
+		Update: resourceAwsEc2TransitGatewayConnectUpdate,
+		Delete: resourceAwsEc2TransitGatewayConnectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.ProtocolValueGre,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ProtocolValueGre,
+				}, false),
+			},
+			"transit_gateway_default_route_table_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"transit_gateway_default_route_table_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transport_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsEc2TransitGatewayConnectCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayConnectInput{
+		Options: &ec2.CreateTransitGatewayConnectRequestOptions{
+			Protocol: aws.String(d.Get("protocol").(string)),
+		},
+		TransportTransitGatewayAttachmentId: aws.String(d.Get("transport_attachment_id").(string)),
+		TagSpecifications:                   ec2TagSpecificationsFromKeyValueTags(t, ec2.ResourceTypeTransitGatewayAttachment),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect: %s", input)
+	output, err := conn.CreateTransitGatewayConnect(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnect.TransitGatewayAttachmentId))
+
+	if _, err := waitTransitGatewayConnectStateAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect (%s) create: %w", d.Id(), err)
+	}
+
+	if err := ec2TransitGatewayRouteTableAssociationUpdate(conn, d.Id(),
+		d.Get("transit_gateway_default_route_table_association").(bool),
+		aws.StringValue(output.TransitGatewayConnect.TransitGatewayId)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Connect (%s) route table association: %w", d.Id(), err)
+	}
+
+	if err := ec2TransitGatewayRouteTablePropagationUpdate(conn, d.Id(),
+		d.Get("transit_gateway_default_route_table_propagation").(bool),
+		aws.StringValue(output.TransitGatewayConnect.TransitGatewayId)); err != nil {
+		return fmt.Errorf("error updating EC2 Transit Gateway Connect (%s) route table propagation: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayConnectRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnect, err := ec2DescribeTransitGatewayConnect(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect (%s): %w", d.Id(), err)
+	}
+
+	if transitGatewayConnect == nil || aws.StringValue(transitGatewayConnect.State) == ec2.TransitGatewayAttachmentStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Connect (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protocol", transitGatewayConnect.Options.Protocol)
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+	d.Set("transport_attachment_id", transitGatewayConnect.TransportTransitGatewayAttachmentId)
+
+	tagsAttr := keyvaluetags.Ec2KeyValueTags(transitGatewayConnect.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tagsAttr.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tagsAttr.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayConnectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayConnectRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayConnect(&ec2.DeleteTransitGatewayConnectInput{
+		TransitGatewayAttachmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayConnectStateDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ec2DescribeTransitGatewayConnect(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnects(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnects) == 0 {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnects[0], nil
+}
+
+func waitTransitGatewayConnectStateAvailable(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: transitGatewayConnectRefreshFunc(conn, id),
+		Timeout: 10 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayConnectStateDeleted(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStateAvailable, ec2.TransitGatewayAttachmentStateDeleting},
+		Target:  []string{},
+		Refresh: transitGatewayConnectRefreshFunc(conn, id),
+		Timeout: 10 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func transitGatewayConnectRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := ec2DescribeTransitGatewayConnect(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
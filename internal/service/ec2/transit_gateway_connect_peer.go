@@ -0,0 +1,262 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+)
+
+func ResourceTransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayConnectPeerCreate,
+		Read:   resourceAwsEc2TransitGatewayConnectPeerRead,
+		Update: resourceAwsEc2TransitGatewayConnectPeerUpdate,
+		Delete: resourceAwsEc2TransitGatewayConnectPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bgp_asn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"transit_gateway_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"tags":     tags.TagsSchema(),
+			"tags_all": tags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: tags.SetTagsDiff,
+	}
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	t := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayConnectPeerInput{
+		InsideCidrBlocks:           flex.ExpandStringList(d.Get("inside_cidr_blocks").([]interface{})),
+		PeerAddress:                aws.String(d.Get("peer_address").(string)),
+		TransitGatewayAttachmentId: aws.String(d.Get("transit_gateway_attachment_id").(string)),
+		TagSpecifications:          ec2TagSpecificationsFromKeyValueTags(t, ec2.ResourceTypeTransitGatewayConnectPeer),
+	}
+
+	if v, ok := d.GetOk("bgp_asn"); ok {
+		input.BgpOptions = &ec2.TransitGatewayConnectRequestBgpOptions{
+			PeerAsn: aws.Int64(flex.StringToInt64Value(v.(string))),
+		}
+	}
+
+	if v, ok := d.GetOk("transit_gateway_address"); ok {
+		input.TransitGatewayAddress = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Peer: %s", input)
+	output, err := conn.CreateTransitGatewayConnectPeer(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Peer: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	if _, err := waitTransitGatewayConnectPeerStateAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*client.AWSClient).IgnoreTagsConfig
+
+	connectPeer, err := ec2DescribeTransitGatewayConnectPeer(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	if connectPeer == nil || aws.StringValue(connectPeer.State) == ec2.TransitGatewayConnectPeerStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("transit_gateway_attachment_id", connectPeer.TransitGatewayAttachmentId)
+
+	if bgp := connectPeer.ConnectPeerConfiguration; bgp != nil {
+		d.Set("peer_address", bgp.PeerAddress)
+		d.Set("transit_gateway_address", bgp.TransitGatewayAddress)
+		d.Set("inside_cidr_blocks", flex.FlattenStringList(bgp.InsideCidrBlocks))
+
+		if len(bgp.BgpConfigurations) > 0 {
+			d.Set("bgp_asn", bgp.BgpConfigurations[0].PeerAsn)
+		}
+	}
+
+	tagsAttr := keyvaluetags.Ec2KeyValueTags(connectPeer.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tagsAttr.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tagsAttr.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Peer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayConnectPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Peer: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayConnectPeer(&ec2.DeleteTransitGatewayConnectPeerInput{
+		TransitGatewayConnectPeerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Peer (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayConnectPeerStateDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ec2DescribeTransitGatewayConnectPeer(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnectPeers(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnectPeers) == 0 {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnectPeers[0], nil
+}
+
+func waitTransitGatewayConnectPeerStateAvailable(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStatePending},
+		Target:  []string{ec2.TransitGatewayConnectPeerStateAvailable},
+		Refresh: transitGatewayConnectPeerRefreshFunc(conn, id),
+		Timeout: 10 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayConnectPeerStateDeleted(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStateAvailable, ec2.TransitGatewayConnectPeerStateDeleting},
+		Target:  []string{},
+		Refresh: transitGatewayConnectPeerRefreshFunc(conn, id),
+		Timeout: 10 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func transitGatewayConnectPeerRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := ec2DescribeTransitGatewayConnectPeer(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
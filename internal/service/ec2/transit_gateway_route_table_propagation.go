@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -10,8 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tfresource"
 )
 
+// transitGatewayRouteTablePropagationRetryTimeout allows the enable/disable calls to retry past
+// the brief window where the API rejects them with an IncorrectState "has not been associated" error.
+const transitGatewayRouteTablePropagationRetryTimeout = 2 * time.Minute
+
 func ResourceTransitGatewayRouteTablePropagation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEc2TransitGatewayRouteTablePropagationCreate,
@@ -57,7 +63,13 @@ func resourceAwsEc2TransitGatewayRouteTablePropagationCreate(d *schema.ResourceD
 		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
 	}
 
-	_, err := conn.EnableTransitGatewayRouteTablePropagation(input)
+	_, err := tfresource.RetryWhenAwsErrCodeMatches(
+		transitGatewayRouteTablePropagationRetryTimeout,
+		func() (interface{}, error) {
+			return conn.EnableTransitGatewayRouteTablePropagation(input)
+		},
+		tfresource.RetryMatcher{Code: "IncorrectState", MessageSubstring: "has not been associated"},
+	)
 	if err != nil {
 		return fmt.Errorf("error enabling EC2 Transit Gateway Route Table (%s) propagation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, err)
 	}
@@ -123,7 +135,13 @@ func resourceAwsEc2TransitGatewayRouteTablePropagationDelete(d *schema.ResourceD
 	}
 
 	log.Printf("[DEBUG] Disabling EC2 Transit Gateway Route Table (%s) Propagation (%s): %s", transitGatewayRouteTableID, transitGatewayAttachmentID, input)
-	_, err = conn.DisableTransitGatewayRouteTablePropagation(input)
+	_, err = tfresource.RetryWhenAwsErrCodeMatches(
+		transitGatewayRouteTablePropagationRetryTimeout,
+		func() (interface{}, error) {
+			return conn.DisableTransitGatewayRouteTablePropagation(input)
+		},
+		tfresource.RetryMatcher{Code: "IncorrectState", MessageSubstring: "has not been associated"},
+	)
 
 	if tfawserr.ErrMessageContains(err, "InvalidRouteTableID.NotFound", "") {
 		return nil
@@ -4,15 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
 	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
-	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
 )
 
 func DataSourceFileSystem() *schema.Resource {
@@ -59,7 +61,34 @@ func DataSourceFileSystem() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tags.TagsSchemaComputed(),
+			// tags doubles as an input filter (the file system's tags must be a superset of the
+			// given map) and, once a single file system is resolved, as its full tag set.
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// filter mirrors the name/values filter blocks other data sources build on top of EC2's
+			// native Filters -- EFS's DescribeFileSystems has no server-side filtering, so these are
+			// applied locally against the pseudo-attributes below.
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"throughput_mode": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,6 +113,100 @@ func DataSourceFileSystem() *schema.Resource {
 					},
 				},
 			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mount_targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"access_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_directory": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"posix_user": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uid": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"gid": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"secondary_gids": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -102,21 +225,48 @@ func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) er
 		describeEfsOpts.FileSystemId = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] Reading EFS File System: %s", describeEfsOpts)
-	describeResp, err := conn.DescribeFileSystems(describeEfsOpts)
+	log.Printf("[DEBUG] Reading EFS File Systems: %s", describeEfsOpts)
+	var fileSystems []*efs.FileSystemDescription
+	err := conn.DescribeFileSystemsPages(describeEfsOpts, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+		fileSystems = append(fileSystems, page.FileSystems...)
+		return !lastPage
+	})
 	if err != nil {
 		return fmt.Errorf("error reading EFS FileSystem: %w", err)
 	}
 
-	if describeResp == nil || len(describeResp.FileSystems) == 0 {
+	if v, ok := d.GetOk("filter"); ok {
+		for _, f := range v.(*schema.Set).List() {
+			m := f.(map[string]interface{})
+			name := m["name"].(string)
+			values := flex.ExpandStringList(m["values"].([]interface{}))
+
+			fileSystems = filterEfsFileSystems(fileSystems, func(fs *efs.FileSystemDescription) bool {
+				return matchesEfsFileSystemFilter(fs, name, values)
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		wantTags := make(map[string]string, len(v.(map[string]interface{})))
+		for k, val := range v.(map[string]interface{}) {
+			wantTags[k] = val.(string)
+		}
+
+		fileSystems = filterEfsFileSystems(fileSystems, func(fs *efs.FileSystemDescription) bool {
+			return matchesEfsFileSystemTags(fs, wantTags)
+		})
+	}
+
+	if len(fileSystems) == 0 {
 		return errors.New("error reading EFS FileSystem: empty output")
 	}
 
-	if len(describeResp.FileSystems) > 1 {
-		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(describeResp.FileSystems))
+	if len(fileSystems) > 1 {
+		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(fileSystems))
 	}
 
-	fs := describeResp.FileSystems[0]
+	fs := fileSystems[0]
 
 	d.SetId(aws.StringValue(fs.FileSystemId))
 	d.Set("availability_zone_id", fs.AvailabilityZoneId)
@@ -160,5 +310,189 @@ func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("dns_name", meta.(*client.AWSClient).RegionalHostname(fmt.Sprintf("%s.efs", aws.StringValue(fs.FileSystemId))))
 
+	policyOutput, err := conn.DescribeFileSystemPolicy(&efs.DescribeFileSystemPolicyInput{
+		FileSystemId: fs.FileSystemId,
+	})
+	if err != nil && !tfawserr.ErrCodeEquals(err, efs.ErrCodePolicyNotFound) {
+		return fmt.Errorf("error describing file system policy for EFS file system (%s): %w", aws.StringValue(fs.FileSystemId), err)
+	}
+	if err == nil {
+		d.Set("policy", policyOutput.Policy)
+	}
+
+	backupPolicyOutput, err := conn.DescribeBackupPolicy(&efs.DescribeBackupPolicyInput{
+		FileSystemId: fs.FileSystemId,
+	})
+	if err != nil {
+		return fmt.Errorf("error describing backup policy for EFS file system (%s): %w", aws.StringValue(fs.FileSystemId), err)
+	}
+	if err := d.Set("backup_policy", flattenEfsBackupPolicy(backupPolicyOutput.BackupPolicy)); err != nil {
+		return fmt.Errorf("error setting backup_policy: %w", err)
+	}
+
+	var mountTargets []*efs.MountTargetDescription
+	err = conn.DescribeMountTargetsPages(&efs.DescribeMountTargetsInput{
+		FileSystemId: fs.FileSystemId,
+	}, func(page *efs.DescribeMountTargetsOutput, lastPage bool) bool {
+		mountTargets = append(mountTargets, page.MountTargets...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error describing mount targets for EFS file system (%s): %w", aws.StringValue(fs.FileSystemId), err)
+	}
+	if err := d.Set("mount_targets", flattenEfsMountTargets(mountTargets)); err != nil {
+		return fmt.Errorf("error setting mount_targets: %w", err)
+	}
+
+	var accessPoints []*efs.AccessPointDescription
+	err = conn.DescribeAccessPointsPages(&efs.DescribeAccessPointsInput{
+		FileSystemId: fs.FileSystemId,
+	}, func(page *efs.DescribeAccessPointsOutput, lastPage bool) bool {
+		accessPoints = append(accessPoints, page.AccessPoints...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error describing access points for EFS file system (%s): %w", aws.StringValue(fs.FileSystemId), err)
+	}
+	if err := d.Set("access_points", flattenEfsAccessPoints(accessPoints, ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting access_points: %w", err)
+	}
+
 	return nil
+}
+
+func flattenEfsBackupPolicy(policy *efs.BackupPolicy) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status": aws.StringValue(policy.Status),
+		},
+	}
+}
+
+func flattenEfsMountTargets(mountTargets []*efs.MountTargetDescription) []interface{} {
+	out := make([]interface{}, 0, len(mountTargets))
+
+	for _, mt := range mountTargets {
+		out = append(out, map[string]interface{}{
+			"id":                     aws.StringValue(mt.MountTargetId),
+			"subnet_id":              aws.StringValue(mt.SubnetId),
+			"ip_address":             aws.StringValue(mt.IpAddress),
+			"network_interface_id":   aws.StringValue(mt.NetworkInterfaceId),
+			"availability_zone_id":   aws.StringValue(mt.AvailabilityZoneId),
+			"availability_zone_name": aws.StringValue(mt.AvailabilityZoneName),
+		})
+	}
+
+	return out
+}
+
+func flattenEfsAccessPoints(accessPoints []*efs.AccessPointDescription, ignoreTagsConfig *keyvaluetags.IgnoreConfig) []interface{} {
+	out := make([]interface{}, 0, len(accessPoints))
+
+	for _, ap := range accessPoints {
+		var rootDirectoryPath string
+		if ap.RootDirectory != nil {
+			rootDirectoryPath = aws.StringValue(ap.RootDirectory.Path)
+		}
+
+		out = append(out, map[string]interface{}{
+			"id":             aws.StringValue(ap.AccessPointId),
+			"arn":            aws.StringValue(ap.AccessPointArn),
+			"root_directory": rootDirectoryPath,
+			"posix_user":     flattenEfsAccessPointPosixUser(ap.PosixUser),
+			"tags":           keyvaluetags.EfsKeyValueTags(ap.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+		})
+	}
+
+	return out
+}
+
+func flattenEfsAccessPointPosixUser(posixUser *efs.PosixUser) []interface{} {
+	if posixUser == nil {
+		return nil
+	}
+
+	secondaryGids := make([]interface{}, 0, len(posixUser.SecondaryGids))
+	for _, gid := range posixUser.SecondaryGids {
+		secondaryGids = append(secondaryGids, int(aws.Int64Value(gid)))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uid":            int(aws.Int64Value(posixUser.Uid)),
+			"gid":            int(aws.Int64Value(posixUser.Gid)),
+			"secondary_gids": secondaryGids,
+		},
+	}
+}
+
+// filterEfsFileSystems returns the subset of fileSystems for which keep returns true.
+func filterEfsFileSystems(fileSystems []*efs.FileSystemDescription, keep func(*efs.FileSystemDescription) bool) []*efs.FileSystemDescription {
+	var matched []*efs.FileSystemDescription
+
+	for _, fs := range fileSystems {
+		if keep(fs) {
+			matched = append(matched, fs)
+		}
+	}
+
+	return matched
+}
+
+// matchesEfsFileSystemFilter reports whether fs matches one of values for the given pseudo-filter
+// name, the EFS equivalent of the tag/attribute filters other data sources pass straight through
+// to EC2's native Filters.
+func matchesEfsFileSystemFilter(fs *efs.FileSystemDescription, name string, values []*string) bool {
+	var actual string
+
+	switch name {
+	case "name":
+		for _, t := range fs.Tags {
+			if aws.StringValue(t.Key) == "Name" {
+				actual = aws.StringValue(t.Value)
+			}
+		}
+	case "performance-mode":
+		actual = aws.StringValue(fs.PerformanceMode)
+	case "throughput-mode":
+		actual = aws.StringValue(fs.ThroughputMode)
+	case "encrypted":
+		actual = strconv.FormatBool(aws.BoolValue(fs.Encrypted))
+	case "availability-zone-name":
+		actual = aws.StringValue(fs.AvailabilityZoneName)
+	default:
+		return false
+	}
+
+	for _, v := range values {
+		if aws.StringValue(v) == actual {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesEfsFileSystemTags reports whether fs's tags are a superset of want.
+func matchesEfsFileSystemTags(fs *efs.FileSystemDescription, want map[string]string) bool {
+	if len(want) == 0 {
+		return true
+	}
+
+	actual := make(map[string]string, len(fs.Tags))
+	for _, t := range fs.Tags {
+		actual[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	for k, v := range want {
+		if actual[k] != v {
+			return false
+		}
+	}
+
+	return true
 }
\ No newline at end of file
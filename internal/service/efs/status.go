@@ -9,62 +9,48 @@ import (
 
 // statusAccessPointLifeCycleState fetches the Access Point and its LifecycleState
 func statusAccessPointLifeCycleState(conn *efs.EFS, accessPointId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &efs.DescribeAccessPointsInput{
+	return tfresource.RefreshFuncFromAPI(func() (interface{}, error) {
+		output, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
 			AccessPointId: aws.String(accessPointId),
-		}
-
-		output, err := conn.DescribeAccessPoints(input)
-
+		})
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
 
-		if output == nil || len(output.AccessPoints) == 0 || output.AccessPoints[0] == nil {
-			return nil, "", nil
+		if len(output.AccessPoints) == 0 {
+			return nil, nil
 		}
 
-		mt := output.AccessPoints[0]
-
-		return mt, aws.StringValue(mt.LifeCycleState), nil
-	}
+		return output.AccessPoints[0], nil
+	}, func(v interface{}) string {
+		return aws.StringValue(v.(*efs.AccessPointDescription).LifeCycleState)
+	}, "")
 }
 
 func statusBackupPolicy(conn *efs.EFS, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findBackupPolicyByID(conn, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
-	}
+	return tfresource.RefreshFuncFromAPI(func() (interface{}, error) {
+		return findBackupPolicyByID(conn, id)
+	}, func(v interface{}) string {
+		return aws.StringValue(v.(*efs.BackupPolicy).Status)
+	}, "")
 }
 
 // statusFileSystemLifeCycleState fetches the Access Point and its LifecycleState
 func statusFileSystemLifeCycleState(conn *efs.EFS, fileSystemID string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &efs.DescribeFileSystemsInput{
+	return tfresource.RefreshFuncFromAPI(func() (interface{}, error) {
+		output, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(fileSystemID),
-		}
-
-		output, err := conn.DescribeFileSystems(input)
-
+		})
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
 
-		if output == nil || len(output.FileSystems) == 0 || output.FileSystems[0] == nil {
-			return nil, "", nil
+		if len(output.FileSystems) == 0 {
+			return nil, nil
 		}
 
-		mt := output.FileSystems[0]
-
-		return mt, aws.StringValue(mt.LifeCycleState), nil
-	}
-}
\ No newline at end of file
+		return output.FileSystems[0], nil
+	}, func(v interface{}) string {
+		return aws.StringValue(v.(*efs.FileSystemDescription).LifeCycleState)
+	}, "")
+}
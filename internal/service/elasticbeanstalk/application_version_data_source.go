@@ -0,0 +1,101 @@
+package elasticbeanstalk
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+)
+
+func DataSourceApplicationVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticBeanstalkApplicationVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_bundle": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsElasticBeanstalkApplicationVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).ElasticBeanstalkConn
+
+	application := d.Get("application").(string)
+	name := d.Get("name").(string)
+
+	output, err := conn.DescribeApplicationVersions(&elasticbeanstalk.DescribeApplicationVersionsInput{
+		ApplicationName: aws.String(application),
+		VersionLabels:   aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Elastic Beanstalk Application Version (%s/%s): %w", application, name, err)
+	}
+
+	if output == nil || len(output.ApplicationVersions) == 0 {
+		return fmt.Errorf("error describing Elastic Beanstalk Application Version (%s/%s): no results found", application, name)
+	}
+
+	if len(output.ApplicationVersions) > 1 {
+		return fmt.Errorf("error describing Elastic Beanstalk Application Version (%s/%s): multiple results found, try a more specific search", application, name)
+	}
+
+	version := output.ApplicationVersions[0]
+
+	d.SetId(aws.StringValue(version.ApplicationVersionArn))
+	d.Set("application", version.ApplicationName)
+	d.Set("name", version.VersionLabel)
+	d.Set("description", version.Description)
+	d.Set("arn", version.ApplicationVersionArn)
+
+	if err := d.Set("source_bundle", flattenElasticBeanstalkSourceBundle(version.SourceBundle)); err != nil {
+		return fmt.Errorf("error setting source_bundle: %w", err)
+	}
+
+	return nil
+}
+
+func flattenElasticBeanstalkSourceBundle(bundle *elasticbeanstalk.S3Location) []interface{} {
+	if bundle == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"s3_bucket": aws.StringValue(bundle.S3Bucket),
+			"s3_key":    aws.StringValue(bundle.S3Key),
+		},
+	}
+}
@@ -0,0 +1,130 @@
+package elasticbeanstalk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+)
+
+func TestAccAwsElasticBeanstalkApplicationVersionDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	dataSourceResourceName := "data.aws_elastic_beanstalk_application_version.test"
+	resourceName := "aws_elastic_beanstalk_application_version.tftest"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAwsElasticBeanstalkApplicationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsElasticBeanstalkApplicationVersionDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "description", dataSourceResourceName, "description"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", dataSourceResourceName, "source_bundle.0.s3_bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "key", dataSourceResourceName, "source_bundle.0.s3_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsElasticBeanstalkApplicationVersionDataSourceConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_elastic_beanstalk_application_version" "test" {
+  application = aws_elastic_beanstalk_application_version.tftest.application
+  name        = aws_elastic_beanstalk_application_version.tftest.name
+}
+`, testAccBeanstalkAppConfigWithMaxAge(rName))
+}
+
+func testAccCheckAwsElasticBeanstalkApplicationVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*client.AWSClient).ElasticBeanstalkConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elastic_beanstalk_application_version" {
+			continue
+		}
+
+		output, err := conn.DescribeApplicationVersions(&elasticbeanstalk.DescribeApplicationVersionsInput{
+			ApplicationName: aws.String(rs.Primary.Attributes["application"]),
+			VersionLabels:   aws.StringSlice([]string{rs.Primary.Attributes["name"]}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.ApplicationVersions) > 0 {
+			return fmt.Errorf("Elastic Beanstalk Application Version (%s) still exists", rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
+
+func testAccBeanstalkAppConfigWithMaxAge(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "tftest" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "tftest" {
+  bucket  = aws_s3_bucket.tftest.id
+  key     = "beanstalk/python-v1.zip"
+  content = "tf-acc-test-app-version-content"
+}
+
+resource "aws_iam_role" "tftest" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "elasticbeanstalk.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "tftest" {
+  role       = aws_iam_role.tftest.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSElasticBeanstalkServiceRolePolicy"
+}
+
+resource "aws_elastic_beanstalk_application" "tftest" {
+  name        = %[1]q
+  description = "tf-acc-test-desc"
+
+  appversion_lifecycle {
+    service_role          = aws_iam_role.tftest.arn
+    max_age_in_days       = 90
+    delete_source_from_s3 = true
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.tftest]
+}
+
+resource "aws_elastic_beanstalk_application_version" "tftest" {
+  application = aws_elastic_beanstalk_application.tftest.name
+  name        = %[1]q
+  description = "tf-acc-test-version-desc"
+  bucket      = aws_s3_bucket.tftest.id
+  key         = aws_s3_object.tftest.key
+}
+`, rName)
+}
@@ -0,0 +1,118 @@
+package elasticbeanstalk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+)
+
+// DataSourcePlatform looks up the latest Elastic Beanstalk platform branch matching the
+// given filters, so environments can be pinned to the newest non-deprecated platform
+// without an external script polling ListPlatformVersions.
+func DataSourcePlatform() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsElasticBeanstalkPlatformRead,
+
+		Schema: map[string]*schema.Schema{
+			"operating_system_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"platform_category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"platform_branch_lifecycle_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  elasticbeanstalk.PlatformBranchLifecycleStateSupported,
+				ValidateFunc: validation.StringInSlice([]string{
+					elasticbeanstalk.PlatformBranchLifecycleStateSupported,
+					elasticbeanstalk.PlatformBranchLifecycleStateBeta,
+					elasticbeanstalk.PlatformBranchLifecycleStateDeprecated,
+					elasticbeanstalk.PlatformBranchLifecycleStateRetired,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsElasticBeanstalkPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).ElasticBeanstalkConn
+
+	filters := []*elasticbeanstalk.PlatformFilter{
+		{
+			Type:     aws.String("PlatformBranchLifecycleState"),
+			Operator: aws.String("="),
+			Values:   aws.StringSlice([]string{d.Get("platform_branch_lifecycle_state").(string)}),
+		},
+	}
+
+	if v, ok := d.GetOk("operating_system_name"); ok {
+		filters = append(filters, &elasticbeanstalk.PlatformFilter{
+			Type:     aws.String("OperatingSystemName"),
+			Operator: aws.String("="),
+			Values:   aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	if v, ok := d.GetOk("platform_category"); ok {
+		filters = append(filters, &elasticbeanstalk.PlatformFilter{
+			Type:     aws.String("PlatformCategory"),
+			Operator: aws.String("="),
+			Values:   aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	var summaries []*elasticbeanstalk.PlatformSummary
+	err := conn.ListPlatformVersionsPages(&elasticbeanstalk.ListPlatformVersionsInput{
+		Filters: filters,
+	}, func(page *elasticbeanstalk.ListPlatformVersionsOutput, lastPage bool) bool {
+		summaries = append(summaries, page.PlatformSummaryList...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Elastic Beanstalk Platform Versions: %w", err)
+	}
+
+	if len(summaries) == 0 {
+		return fmt.Errorf("error finding Elastic Beanstalk Platform Version: no results found")
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return aws.TimeValue(summaries[i].DateCreated).After(aws.TimeValue(summaries[j].DateCreated))
+	})
+
+	latest := summaries[0]
+
+	output, err := conn.DescribePlatformVersion(&elasticbeanstalk.DescribePlatformVersionInput{
+		PlatformArn: latest.PlatformArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Elastic Beanstalk Platform Version (%s): %w", aws.StringValue(latest.PlatformArn), err)
+	}
+
+	d.SetId(aws.StringValue(output.PlatformDescription.PlatformArn))
+	d.Set("name", output.PlatformDescription.PlatformName)
+	d.Set("arn", output.PlatformDescription.PlatformArn)
+	d.Set("platform_owner", output.PlatformDescription.PlatformOwner)
+
+	return nil
+}
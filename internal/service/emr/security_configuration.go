@@ -1,6 +1,8 @@
 package emr
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
@@ -11,8 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tfresource"
 )
 
+// emrSecurityConfigurationCreateTimeout allows CreateSecurityConfiguration to retry past the brief
+// window where a freshly created IAM role or KMS key referenced by the configuration has not yet
+// propagated, which otherwise surfaces as a spurious InvalidRequestException on first apply.
+const emrSecurityConfigurationCreateTimeout = 2 * time.Minute
+
 func ResourceSecurityConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEmrSecurityConfigurationCreate,
@@ -40,10 +48,218 @@ func ResourceSecurityConfiguration() *schema.Resource {
 			},
 
 			"configuration": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"configuration_json"},
+				ValidateFunc:  validation.All(validation.StringIsJSON, validateEmrSecurityConfigurationJSON),
+			},
+
+			"configuration_json": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_at_rest_encryption": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"enable_in_transit_encryption": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"at_rest_encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_encryption_mode": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"SSE-S3",
+														"SSE-KMS",
+														"CSE-KMS",
+														"CSE-Custom",
+													}, false),
+												},
+												"s3_encryption_key_arn": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"local_disk_encryption_key_provider_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"AwsKms",
+														"Custom",
+													}, false),
+												},
+												"local_disk_encryption_key_arn": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"in_transit_encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tls_certificate_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"certificate_provider_type": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	"PEM",
+																	"Custom",
+																}, false),
+															},
+															"s3_object": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"authentication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kerberos_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"provider": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"ClusterDedicatedKdc",
+													}, false),
+												},
+												"cluster_dedicated_kdc_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ticket_lifetime_in_hours": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"cross_realm_trust_configuration": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"realm": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																		"domain": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																		"admin_server": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																		"kdc_server": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"instance_metadata_service_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_instance_metadata_service_version": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntInSlice([]int{1, 2}),
+									},
+									"http_put_response_hop_limit": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 64),
+									},
+								},
+							},
+						},
+
+						"authorization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lake_formation_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"authorized_session_tag_value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"emr_role_for_service_access_arn": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 
 			"creation_date": {
@@ -68,16 +284,36 @@ func resourceAwsEmrSecurityConfigurationCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	resp, err := conn.CreateSecurityConfiguration(&emr.CreateSecurityConfigurationInput{
-		Name:                  aws.String(emrSCName),
-		SecurityConfiguration: aws.String(d.Get("configuration").(string)),
-	})
+	configuration := d.Get("configuration").(string)
+	if v, ok := d.GetOk("configuration_json"); ok {
+		rendered, err := flattenEmrSecurityConfigurationJSON(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("error rendering configuration_json: %w", err)
+		}
+		configuration = rendered
+	}
+
+	if configuration == "" {
+		return fmt.Errorf("one of `configuration` or `configuration_json` must be set")
+	}
+
+	output, err := tfresource.RetryWhenAwsErrCodeMatches(
+		emrSecurityConfigurationCreateTimeout,
+		func() (interface{}, error) {
+			return conn.CreateSecurityConfiguration(&emr.CreateSecurityConfigurationInput{
+				Name:                  aws.String(emrSCName),
+				SecurityConfiguration: aws.String(configuration),
+			})
+		},
+		tfresource.RetryMatcher{Code: "InvalidRequestException", MessageSubstring: "is not authorized"},
+		tfresource.RetryMatcher{Code: "ThrottlingException"},
+	)
 
 	if err != nil {
 		return err
 	}
 
-	d.SetId(aws.StringValue(resp.Name))
+	d.SetId(aws.StringValue(output.(*emr.CreateSecurityConfigurationOutput).Name))
 	return resourceAwsEmrSecurityConfigurationRead(d, meta)
 }
 
@@ -98,7 +334,10 @@ func resourceAwsEmrSecurityConfigurationRead(d *schema.ResourceData, meta interf
 
 	d.Set("creation_date", aws.TimeValue(resp.CreationDateTime).Format(time.RFC3339))
 	d.Set("name", resp.Name)
-	d.Set("configuration", resp.SecurityConfiguration)
+
+	if _, ok := d.GetOk("configuration_json"); !ok {
+		d.Set("configuration", resp.SecurityConfiguration)
+	}
 
 	return nil
 }
@@ -117,4 +356,192 @@ func resourceAwsEmrSecurityConfigurationDelete(d *schema.ResourceData, meta inte
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// validateEmrSecurityConfigurationJSON parses the raw `configuration` JSON string and checks it
+// against every enum/range-constrained field `configuration_json`'s own per-leaf ValidateFuncs
+// enforce below, so the two mutually exclusive ways of supplying a security configuration reject
+// the same malformed values at plan time instead of the `configuration` path only catching a couple
+// of them and letting the rest fail at apply time with the AWS API's generic InvalidRequestException.
+func validateEmrSecurityConfigurationJSON(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %w", k, err))
+		return
+	}
+
+	if enc, ok := parsed["EncryptionConfiguration"].(map[string]interface{}); ok {
+		if atRest, ok := enc["AtRestEncryptionConfiguration"].(map[string]interface{}); ok {
+			if s3, ok := atRest["S3EncryptionConfiguration"].(map[string]interface{}); ok {
+				if mode, ok := s3["EncryptionMode"].(string); ok {
+					switch mode {
+					case "SSE-S3", "SSE-KMS", "CSE-KMS", "CSE-Custom":
+					default:
+						errors = append(errors, fmt.Errorf("%q: encryption_at_rest.s3.mode must be one of SSE-S3/SSE-KMS/CSE-KMS/CSE-Custom, got %q", k, mode))
+					}
+				}
+			}
+
+			if localDisk, ok := atRest["LocalDiskEncryptionConfiguration"].(map[string]interface{}); ok {
+				if provider, ok := localDisk["EncryptionKeyProviderType"].(string); ok {
+					switch provider {
+					case "AwsKms", "Custom":
+					default:
+						errors = append(errors, fmt.Errorf("%q: encryption_at_rest.local_disk.key_provider_type must be one of AwsKms/Custom, got %q", k, provider))
+					}
+				}
+			}
+		}
+
+		if inTransit, ok := enc["InTransitEncryptionConfiguration"].(map[string]interface{}); ok {
+			if tls, ok := inTransit["TLSCertificateConfiguration"].(map[string]interface{}); ok {
+				if provider, ok := tls["CertificateProviderType"].(string); ok {
+					switch provider {
+					case "PEM", "Custom":
+					default:
+						errors = append(errors, fmt.Errorf("%q: encryption_in_transit.tls.certificate_provider_type must be one of PEM/Custom, got %q", k, provider))
+					}
+				}
+			}
+		}
+	}
+
+	if auth, ok := parsed["AuthenticationConfiguration"].(map[string]interface{}); ok {
+		if kerberos, ok := auth["KerberosConfiguration"].(map[string]interface{}); ok {
+			if provider, ok := kerberos["Provider"].(string); ok {
+				switch provider {
+				case "ClusterDedicatedKdc":
+				default:
+					errors = append(errors, fmt.Errorf("%q: authentication.kerberos.provider must be ClusterDedicatedKdc, got %q", k, provider))
+				}
+			}
+		}
+	}
+
+	if imds, ok := parsed["InstanceMetadataServiceConfiguration"].(map[string]interface{}); ok {
+		if version, ok := imds["MinimumInstanceMetadataServiceVersion"].(float64); ok {
+			if version != 1 && version != 2 {
+				errors = append(errors, fmt.Errorf("%q: instance_metadata_service_configuration.minimum_instance_metadata_service_version must be 1 or 2, got %v", k, version))
+			}
+		}
+
+		if hopLimit, ok := imds["HttpPutResponseHopLimit"].(float64); ok {
+			if hopLimit < 1 || hopLimit > 64 {
+				errors = append(errors, fmt.Errorf("%q: instance_metadata_service_configuration.http_put_response_hop_limit must be between 1 and 64, got %v", k, hopLimit))
+			}
+		}
+	}
+
+	return
+}
+
+// flattenEmrSecurityConfigurationJSON renders the `configuration_json` nested block attributes
+// into the raw JSON document the EMR API expects for SecurityConfiguration.
+func flattenEmrSecurityConfigurationJSON(l []interface{}) (string, error) {
+	if len(l) == 0 || l[0] == nil {
+		return "", nil
+	}
+	m := l[0].(map[string]interface{})
+
+	doc := map[string]interface{}{}
+
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ec := v[0].(map[string]interface{})
+		encConfig := map[string]interface{}{
+			"EnableAtRestEncryption":    ec["enable_at_rest_encryption"],
+			"EnableInTransitEncryption": ec["enable_in_transit_encryption"],
+		}
+
+		if v, ok := ec["at_rest_encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			arc := v[0].(map[string]interface{})
+			atRest := map[string]interface{}{}
+			if mode, ok := arc["s3_encryption_mode"].(string); ok && mode != "" {
+				atRest["S3EncryptionConfiguration"] = map[string]interface{}{
+					"EncryptionMode":   mode,
+					"EncryptionKeyArn": arc["s3_encryption_key_arn"],
+				}
+			}
+			if provider, ok := arc["local_disk_encryption_key_provider_type"].(string); ok && provider != "" {
+				atRest["LocalDiskEncryptionConfiguration"] = map[string]interface{}{
+					"EncryptionKeyProviderType": provider,
+					"AwsKmsKey":                 arc["local_disk_encryption_key_arn"],
+				}
+			}
+			encConfig["AtRestEncryptionConfiguration"] = atRest
+		}
+
+		if v, ok := ec["in_transit_encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			itc := v[0].(map[string]interface{})
+			inTransit := map[string]interface{}{}
+			if v, ok := itc["tls_certificate_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tls := v[0].(map[string]interface{})
+				inTransit["TLSCertificateConfiguration"] = map[string]interface{}{
+					"CertificateProviderType": tls["certificate_provider_type"],
+					"S3Object":                tls["s3_object"],
+				}
+			}
+			encConfig["InTransitEncryptionConfiguration"] = inTransit
+		}
+
+		doc["EncryptionConfiguration"] = encConfig
+	}
+
+	if v, ok := m["authentication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ac := v[0].(map[string]interface{})
+		if v, ok := ac["kerberos_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			kc := v[0].(map[string]interface{})
+			kerberos := map[string]interface{}{
+				"Provider": kc["provider"],
+			}
+			if v, ok := kc["cluster_dedicated_kdc_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				cdk := v[0].(map[string]interface{})
+				dedicated := map[string]interface{}{
+					"TicketLifetimeInHours": cdk["ticket_lifetime_in_hours"],
+				}
+				if v, ok := cdk["cross_realm_trust_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					crt := v[0].(map[string]interface{})
+					dedicated["CrossRealmTrustConfiguration"] = map[string]interface{}{
+						"Realm":       crt["realm"],
+						"Domain":      crt["domain"],
+						"AdminServer": crt["admin_server"],
+						"KdcServer":   crt["kdc_server"],
+					}
+				}
+				kerberos["ClusterDedicatedKdcConfiguration"] = dedicated
+			}
+			doc["AuthenticationConfiguration"] = map[string]interface{}{
+				"KerberosConfiguration": kerberos,
+			}
+		}
+	}
+
+	if v, ok := m["instance_metadata_service_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		imds := v[0].(map[string]interface{})
+		doc["InstanceMetadataServiceConfiguration"] = map[string]interface{}{
+			"MinimumInstanceMetadataServiceVersion": imds["minimum_instance_metadata_service_version"],
+			"HttpPutResponseHopLimit":               imds["http_put_response_hop_limit"],
+		}
+	}
+
+	if v, ok := m["authorization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ac := v[0].(map[string]interface{})
+		if v, ok := ac["lake_formation_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			lf := v[0].(map[string]interface{})
+			doc["AuthorizationConfiguration"] = map[string]interface{}{
+				"LakeFormationConfiguration": map[string]interface{}{
+					"AuthorizedSessionTagValue": lf["authorized_session_tag_value"],
+					"EmrRoleForServiceAccess":   lf["emr_role_for_service_access_arn"],
+				},
+			}
+		}
+	}
+
+	rendered, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(rendered), nil
+}
@@ -34,8 +34,16 @@ func filterPermissions(input *lakeformation.ListPermissionsInput, tableType stri
 		return filterDatabasePermissions(input.Principal.DataLakePrincipalIdentifier, allPermissions)
 	}
 
+	if input.Resource.LFTag != nil {
+		return filterLFTagPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTag, allPermissions)
+	}
+
+	if input.Resource.LFTagPolicy != nil {
+		return filterLFTagPolicyPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTagPolicy, allPermissions)
+	}
+
 	if tableType == tableTypeTableWithColumns {
-		return filterTableWithColumnsPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.Table, columnNames, excludedColumnNames, columnWildcard, allPermissions)
+		return filterTableWithColumnsPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.TableWithColumns, columnNames, excludedColumnNames, columnWildcard, allPermissions)
 	}
 
 	if input.Resource.Table != nil || tableType == tableTypeTable {
@@ -92,7 +100,7 @@ func filterTablePermissions(principal *string, table *lakeformation.TableResourc
 	return cleanPermissions
 }
 
-func filterTableWithColumnsPermissions(principal *string, twc *lakeformation.TableResource, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+func filterTableWithColumnsPermissions(principal *string, twc *lakeformation.TableWithColumnsResource, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
 	// CREATE PERMS (in)       = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT, SELECT on TableWithColumns, Name = (Table Name), ColumnWildcard
 	//        LIST PERMS (out) = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT         on Table, Name = (Table Name)
 	//        LIST PERMS (out) = SELECT                                             on TableWithColumns, Name = (Table Name), ColumnWildcard
@@ -180,6 +188,87 @@ func filterDatabasePermissions(principal *string, allPermissions []*lakeformatio
 	return cleanPermissions
 }
 
+// filterLFTagPermissions matches permissions granted directly against an LF-Tag (`aws_lakeformation_lf_tag`
+// as a resource, not an expression) by principal, tag key equality, and value-set equality, preserving
+// grant-option permissions the same way filterTablePermissions does for tables.
+func filterLFTagPermissions(principal *string, tag *lakeformation.LFTagKeyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTag == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.LFTag.TagKey) != aws.StringValue(tag.TagKey) {
+			continue
+		}
+
+		if stringSlicesEqualIgnoreOrder(perm.Resource.LFTag.TagValues, tag.TagValues) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// filterLFTagPolicyPermissions matches permissions granted via an LF-Tag expression (a set of
+// key/value tuples evaluated against DATABASE or TABLE resources) by principal, resource type, and
+// expression equality. The expression is a slice of structs rather than a slice of strings, so it
+// cannot reuse stringSlicesEqualIgnoreOrder directly; lfTagExpressionsEqual generalizes the same
+// sort-then-compare approach to LFTag key/value tuples.
+func filterLFTagPolicyPermissions(principal *string, policy *lakeformation.LFTagPolicyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTagPolicy == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.LFTagPolicy.ResourceType) != aws.StringValue(policy.ResourceType) {
+			continue
+		}
+
+		if lfTagExpressionsEqual(perm.Resource.LFTagPolicy.Expression, policy.Expression) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// lfTagExpressionsEqual compares two LF-Tag expressions (key plus sorted value set) ignoring order,
+// generalizing stringSlicesEqualIgnoreOrder to a slice of LFTag structs.
+func lfTagExpressionsEqual(e1, e2 []*lakeformation.LFTag) bool {
+	if len(e1) != len(e2) {
+		return false
+	}
+
+	index := make(map[string][]*string, len(e2))
+	for _, tag := range e2 {
+		index[aws.StringValue(tag.TagKey)] = tag.TagValues
+	}
+
+	for _, tag := range e1 {
+		values, ok := index[aws.StringValue(tag.TagKey)]
+		if !ok {
+			return false
+		}
+		if !stringSlicesEqualIgnoreOrder(tag.TagValues, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func stringSlicesEqualIgnoreOrder(s1, s2 []*string) bool {
 	if len(s1) != len(s2) {
 		return false
@@ -0,0 +1,208 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
+)
+
+func ResourceLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationLFTagCreate,
+		Read:   resourceAwsLakeFormationLFTagRead,
+		Update: resourceAwsLakeFormationLFTagUpdate,
+		Delete: resourceAwsLakeFormationLFTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	tagKey := d.Get("key").(string)
+	input := &lakeformation.CreateLFTagInput{
+		TagKey:    aws.String(tagKey),
+		TagValues: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateLFTag(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag (%s): %w", tagKey, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(input.CatalogId), tagKey))
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := readLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.GetLFTagInput{
+		TagKey: aws.String(tagKey),
+	}
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	output, err := conn.GetLFTag(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("key", output.TagKey)
+
+	if err := d.Set("values", flex.FlattenStringSet(output.TagValues)); err != nil {
+		return fmt.Errorf("error setting values: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationLFTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := readLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("values")
+	oldValues := flex.ExpandStringSet(o.(*schema.Set))
+	newValues := flex.ExpandStringSet(n.(*schema.Set))
+
+	input := &lakeformation.UpdateLFTagInput{
+		TagKey: aws.String(tagKey),
+	}
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	if toAdd := setDifference(newValues, oldValues); len(toAdd) > 0 {
+		input.TagValuesToAdd = toAdd
+	}
+	if toDelete := setDifference(oldValues, newValues); len(toDelete) > 0 {
+		input.TagValuesToDelete = toDelete
+	}
+
+	if input.TagValuesToAdd == nil && input.TagValuesToDelete == nil {
+		return resourceAwsLakeFormationLFTagRead(d, meta)
+	}
+
+	if _, err := conn.UpdateLFTag(input); err != nil {
+		return fmt.Errorf("error updating Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := readLFTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.DeleteLFTagInput{
+		TagKey: aws.String(tagKey),
+	}
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	_, err = conn.DeleteLFTag(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// readLFTagID splits the `catalog_id:key` composite ID used by aws_lakeformation_lf_tag.
+func readLFTagID(id string) (catalogID string, tagKey string, err error) {
+	parts := make([]string, 0, 2)
+	for i := 0; i < len(id); i++ {
+		if id[i] == ':' {
+			parts = append(parts, id[:i], id[i+1:])
+			break
+		}
+	}
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected format for ID (%s), expected catalog_id:key", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// setDifference returns the elements of a that are not present in b, preserving a's ordering.
+func setDifference(a, b []*string) []*string {
+	present := make(map[string]bool, len(b))
+	for _, v := range b {
+		present[aws.StringValue(v)] = true
+	}
+
+	var diff []*string
+	for _, v := range a {
+		if !present[aws.StringValue(v)] {
+			diff = append(diff, v)
+		}
+	}
+
+	return diff
+}
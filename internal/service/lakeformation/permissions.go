@@ -0,0 +1,511 @@
+package lakeformation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+)
+
+const (
+	tableTypeTable            = "Table"
+	tableTypeTableWithColumns = "TableWithColumns"
+	tableNameAllTables        = "ALL_TABLES"
+)
+
+// ResourcePermissions grants Lake Formation permissions to a principal on exactly one of
+// catalog_resource, data_location, database, table, table_with_columns, lf_tag, or lf_tag_policy.
+// Unlike aws_lakeformation_permissions_v2, it reconciles changes to permissions/
+// permissions_with_grant_option by revoking everything it previously granted and re-granting the
+// new set, rather than diffing -- see filterPermissions for why Read has to work around
+// ListPermissions returning more than was granted for tables and tables with columns.
+func ResourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Update: resourceAwsLakeFormationPermissionsUpdate,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"catalog_resource": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"lf_tag_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lakeformation.ResourceTypeDatabase,
+								lakeformation.ResourceTypeTable,
+							}, false),
+						},
+						"expression": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	principal := d.Get("principal").(string)
+	resource, resourceType, _, _, _, _, err := expandPermissionsResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.GrantPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+		Resource:  resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("permissions"); ok {
+		input.Permissions = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation permissions: %s", input)
+	if _, err := conn.GrantPermissions(input); err != nil {
+		return fmt.Errorf("error granting Lake Formation permissions: %w", err)
+	}
+
+	d.SetId(permissionsID(principal, resourceType, resource))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	principal := d.Get("principal").(string)
+	resource, _, tableType, columnNames, excludedColumnNames, columnWildcard, err := expandPermissionsResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+		Resource:  resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	var allPermissions []*lakeformation.PrincipalResourcePermissions
+	err = conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		allPermissions = append(allPermissions, page.PrincipalResourcePermissions...)
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation permissions (%s): %w", d.Id(), err)
+	}
+
+	cleanPermissions := filterPermissions(input, tableType, columnNames, excludedColumnNames, columnWildcard, allPermissions)
+
+	if len(cleanPermissions) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var permissions []string
+	var permissionsWithGrantOption []string
+	for _, perm := range cleanPermissions {
+		permissions = append(permissions, aws.StringValueSlice(perm.Permissions)...)
+		permissionsWithGrantOption = append(permissionsWithGrantOption, aws.StringValueSlice(perm.PermissionsWithGrantOption)...)
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return fmt.Errorf("error setting permissions: %w", err)
+	}
+
+	if err := d.Set("permissions_with_grant_option", permissionsWithGrantOption); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	if !d.HasChange("permissions") && !d.HasChange("permissions_with_grant_option") {
+		return resourceAwsLakeFormationPermissionsRead(d, meta)
+	}
+
+	principal := d.Get("principal").(string)
+	resource, _, _, _, _, _, err := expandPermissionsResource(d)
+	if err != nil {
+		return err
+	}
+
+	revoke := &lakeformation.RevokePermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+		Resource:  resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		revoke.CatalogId = aws.String(v.(string))
+	}
+	oldPermissions, _ := d.GetChange("permissions")
+	revoke.Permissions = aws.StringSlice(expandStringSet(oldPermissions.(*schema.Set)))
+
+	oldPermissionsWithGrantOption, _ := d.GetChange("permissions_with_grant_option")
+	revoke.PermissionsWithGrantOption = aws.StringSlice(expandStringSet(oldPermissionsWithGrantOption.(*schema.Set)))
+
+	log.Printf("[DEBUG] Revoking Lake Formation permissions: %s", revoke)
+	if _, err := conn.RevokePermissions(revoke); err != nil && !tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return fmt.Errorf("error revoking Lake Formation permissions: %w", err)
+	}
+
+	grant := &lakeformation.GrantPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+		Resource:  resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		grant.CatalogId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("permissions"); ok {
+		grant.Permissions = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		grant.PermissionsWithGrantOption = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+
+	log.Printf("[DEBUG] Re-granting Lake Formation permissions: %s", grant)
+	if _, err := conn.GrantPermissions(grant); err != nil {
+		return fmt.Errorf("error granting Lake Formation permissions: %w", err)
+	}
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	principal := d.Get("principal").(string)
+	resource, _, _, _, _, _, err := expandPermissionsResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.RevokePermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+		Resource:  resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("permissions"); ok {
+		input.Permissions = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = aws.StringSlice(expandStringSet(v.(*schema.Set)))
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation permissions: %s", input)
+	_, err = conn.RevokePermissions(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation permissions: %w", err)
+	}
+
+	return nil
+}
+
+// expandPermissionsResource builds the lakeformation.Resource for exactly one of the mutually
+// exclusive resource blocks, returning alongside it the label used in the resource ID
+// (resourceType), and, for table/table_with_columns, the extra values filterPermissions needs to
+// clean up ListPermissions' table-with-columns/SELECT quirks (tableType, columnNames,
+// excludedColumnNames, columnWildcard).
+func expandPermissionsResource(d *schema.ResourceData) (resource *lakeformation.Resource, resourceType string, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, err error) {
+	resource = &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("catalog_resource"); ok && v.(bool) {
+		resource.Catalog = &lakeformation.CatalogResource{}
+		return resource, "CATALOG", "", nil, nil, false, nil
+	}
+
+	if v, ok := d.GetOk("data_location"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.DataLocation = &lakeformation.DataLocationResource{
+			ResourceArn: aws.String(l["arn"].(string)),
+		}
+		return resource, "DATA_LOCATION", "", nil, nil, false, nil
+	}
+
+	if v, ok := d.GetOk("database"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(l["name"].(string)),
+		}
+		return resource, lakeformation.ResourceTypeDatabase, "", nil, nil, false, nil
+	}
+
+	if v, ok := d.GetOk("table"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		table := &lakeformation.TableResource{
+			DatabaseName: aws.String(l["database_name"].(string)),
+		}
+		if name, ok := l["name"].(string); ok && name != "" {
+			table.Name = aws.String(name)
+		}
+		if wildcard, ok := l["wildcard"].(bool); ok && wildcard {
+			table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+		resource.Table = table
+		return resource, lakeformation.ResourceTypeTable, tableTypeTable, nil, nil, false, nil
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		names := expandStringSet(l["column_names"].(*schema.Set))
+		excluded := expandStringSet(l["excluded_column_names"].(*schema.Set))
+		wildcard, _ := l["wildcard"].(bool)
+
+		twc := &lakeformation.TableWithColumnsResource{
+			DatabaseName: aws.String(l["database_name"].(string)),
+			Name:         aws.String(l["name"].(string)),
+		}
+		if len(names) > 0 {
+			twc.ColumnNames = aws.StringSlice(names)
+		}
+		if wildcard || len(excluded) > 0 {
+			twc.ColumnWildcard = &lakeformation.ColumnWildcard{}
+			if len(excluded) > 0 {
+				twc.ColumnWildcard.ExcludedColumnNames = aws.StringSlice(excluded)
+			}
+		}
+		resource.TableWithColumns = twc
+
+		return resource, "TABLE_WITH_COLUMNS", tableTypeTableWithColumns, aws.StringSlice(names), aws.StringSlice(excluded), wildcard, nil
+	}
+
+	if v, ok := d.GetOk("lf_tag"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.LFTag = &lakeformation.LFTagKeyResource{
+			TagKey:    aws.String(l["key"].(string)),
+			TagValues: aws.StringSlice(expandStringSet(l["values"].(*schema.Set))),
+		}
+		return resource, "LF_TAG", "", nil, nil, false, nil
+	}
+
+	if v, ok := d.GetOk("lf_tag_policy"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		policyResourceType := l["resource_type"].(string)
+
+		var expression []*lakeformation.LFTag
+		for _, raw := range l["expression"].(*schema.Set).List() {
+			m := raw.(map[string]interface{})
+			expression = append(expression, &lakeformation.LFTag{
+				TagKey:    aws.String(m["key"].(string)),
+				TagValues: aws.StringSlice(expandStringSet(m["values"].(*schema.Set))),
+			})
+		}
+
+		resource.LFTagPolicy = &lakeformation.LFTagPolicyResource{
+			ResourceType: aws.String(policyResourceType),
+			Expression:   expression,
+		}
+		return resource, "LF_TAG_POLICY", "", nil, nil, false, nil
+	}
+
+	return nil, "", "", nil, nil, false, fmt.Errorf("one of `catalog_resource`, `data_location`, `database`, `table`, `table_with_columns`, `lf_tag`, or `lf_tag_policy` must be set")
+}
+
+// permissionsID builds a composite ID of `<principal>,<resource-type>/<encoded-resource>`. It
+// isn't decoded anywhere (this resource has no Importer, since a principal plus an arbitrary
+// resource block can't be recovered from passthrough import the way aws_lakeformation_permissions_v2
+// needed a real StateContext importer for); it exists purely to give Terraform a stable, unique key.
+func permissionsID(principal string, resourceType string, resource *lakeformation.Resource) string {
+	body, _ := json.Marshal(resource)
+	return fmt.Sprintf("%s,%s/%s", principal, resourceType, base64.RawURLEncoding.EncodeToString(body))
+}
@@ -0,0 +1,553 @@
+package lakeformation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+)
+
+// ResourcePermissionsV2 stores a declarative access_control_list for a Lake Formation resource
+// and reconciles it against AWS via minimal GrantPermissions/RevokePermissions diffs, instead of
+// the revoke-all-then-grant approach aws_lakeformation_permissions takes. This avoids multiple
+// principals on the same table fighting each other on every apply.
+//
+// Migrating a legacy aws_lakeformation_permissions resource is an import, not a state upgrader:
+// `terraform import aws_lakeformation_permissions_v2.example <resource-type>/<encoded-resource>`
+// where <encoded-resource> is the same base64 encoding permissionsV2ID produces. The importer
+// decodes it back into the database/table/lf_tag_policy block Read needs to rebuild the
+// lakeformation.Resource for ListPermissions, after which the old resource can be removed from
+// state and configuration.
+func ResourcePermissionsV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsV2Create,
+		Read:   resourceAwsLakeFormationPermissionsV2Read,
+		Update: resourceAwsLakeFormationPermissionsV2Update,
+		Delete: resourceAwsLakeFormationPermissionsV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAwsLakeFormationPermissionsV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"lf_tag_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lakeformation.ResourceTypeDatabase,
+								lakeformation.ResourceTypeTable,
+							}, false),
+						},
+						"expression": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"access_control_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// aclEntry is the normalized, comparable form of one access_control_list element: the set of
+// Permissions and PermissionsWithGrantOption (GrantPermissions) granted to one Principal. For a
+// table, ListPermissions reports SELECT as its own TableWithColumns entry (see
+// filterTablePermissions); flattenAclEntries merges every entry listed for a principal into one
+// aclEntry, so that SELECT lands in the same Permissions list and aclEntry has no separate SELECT
+// field.
+type aclEntry struct {
+	Principal        string
+	Permissions      []string
+	GrantPermissions []string
+}
+
+// resourceAwsLakeFormationPermissionsV2Import decodes the <resource-type>/<encoded-resource> ID
+// back into a lakeformation.Resource and populates the matching database/table/lf_tag_policy
+// block, since Read (via expandPermissionsV2Resource) needs that block to rebuild the
+// lakeformation.Resource for ListPermissions -- schema.ImportStatePassthrough alone leaves it
+// empty.
+func resourceAwsLakeFormationPermissionsV2Import(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceType, resource, err := decodePermissionsV2ID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := flattenPermissionsV2Resource(d, resourceType, resource); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsLakeFormationPermissionsV2Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, resourceType, err := expandPermissionsV2Resource(d)
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	desired := expandAclEntries(d.Get("access_control_list").(*schema.Set))
+
+	if err := reconcilePermissionsV2(conn, catalogID, resource, nil, desired); err != nil {
+		return fmt.Errorf("error granting Lake Formation permissions: %w", err)
+	}
+
+	d.SetId(permissionsV2ID(resourceType, resource))
+
+	return resourceAwsLakeFormationPermissionsV2Read(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsV2Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, _, err := expandPermissionsV2Resource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Resource: resource,
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	var allPermissions []*lakeformation.PrincipalResourcePermissions
+	err = conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		allPermissions = append(allPermissions, page.PrincipalResourcePermissions...)
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Permissions V2 (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("access_control_list", flattenAclEntries(allPermissions)); err != nil {
+		return fmt.Errorf("error setting access_control_list: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsV2Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, _, err := expandPermissionsV2Resource(d)
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+
+	o, n := d.GetChange("access_control_list")
+	prior := expandAclEntries(o.(*schema.Set))
+	desired := expandAclEntries(n.(*schema.Set))
+
+	if err := reconcilePermissionsV2(conn, catalogID, resource, prior, desired); err != nil {
+		return fmt.Errorf("error reconciling Lake Formation permissions (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLakeFormationPermissionsV2Read(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsV2Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, _, err := expandPermissionsV2Resource(d)
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	prior := expandAclEntries(d.Get("access_control_list").(*schema.Set))
+
+	if err := reconcilePermissionsV2(conn, catalogID, resource, prior, nil); err != nil {
+		return fmt.Errorf("error revoking Lake Formation permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// reconcilePermissionsV2 diffs prior against desired and issues only the GrantPermissions/
+// RevokePermissions calls needed to get from one to the other, rather than revoking everything
+// and re-granting (the approach aws_lakeformation_permissions uses today).
+func reconcilePermissionsV2(conn interface {
+	GrantPermissions(*lakeformation.GrantPermissionsInput) (*lakeformation.GrantPermissionsOutput, error)
+	RevokePermissions(*lakeformation.RevokePermissionsInput) (*lakeformation.RevokePermissionsOutput, error)
+}, catalogID string, resource *lakeformation.Resource, prior, desired []aclEntry) error {
+	priorByPrincipal := make(map[string]aclEntry, len(prior))
+	for _, e := range prior {
+		priorByPrincipal[e.Principal] = e
+	}
+	desiredByPrincipal := make(map[string]aclEntry, len(desired))
+	for _, e := range desired {
+		desiredByPrincipal[e.Principal] = e
+	}
+
+	for principal, d := range desiredByPrincipal {
+		p := priorByPrincipal[principal]
+
+		toGrant := stringSliceDifference(d.Permissions, p.Permissions)
+		toGrantWithOption := stringSliceDifference(d.GrantPermissions, p.GrantPermissions)
+
+		if len(toGrant) > 0 || len(toGrantWithOption) > 0 {
+			input := &lakeformation.GrantPermissionsInput{
+				Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:  resource,
+			}
+			if catalogID != "" {
+				input.CatalogId = aws.String(catalogID)
+			}
+			if len(toGrant) > 0 {
+				input.Permissions = aws.StringSlice(toGrant)
+			}
+			if len(toGrantWithOption) > 0 {
+				input.PermissionsWithGrantOption = aws.StringSlice(toGrantWithOption)
+			}
+
+			if _, err := conn.GrantPermissions(input); err != nil {
+				return fmt.Errorf("error granting permissions to principal (%s): %w", principal, err)
+			}
+		}
+	}
+
+	for principal, p := range priorByPrincipal {
+		d := desiredByPrincipal[principal]
+
+		toRevoke := stringSliceDifference(p.Permissions, d.Permissions)
+		toRevokeWithOption := stringSliceDifference(p.GrantPermissions, d.GrantPermissions)
+
+		if len(toRevoke) > 0 || len(toRevokeWithOption) > 0 {
+			input := &lakeformation.RevokePermissionsInput{
+				Principal: &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:  resource,
+			}
+			if catalogID != "" {
+				input.CatalogId = aws.String(catalogID)
+			}
+			if len(toRevoke) > 0 {
+				input.Permissions = aws.StringSlice(toRevoke)
+			}
+			if len(toRevokeWithOption) > 0 {
+				input.PermissionsWithGrantOption = aws.StringSlice(toRevokeWithOption)
+			}
+
+			if _, err := conn.RevokePermissions(input); err != nil {
+				return fmt.Errorf("error revoking permissions from principal (%s): %w", principal, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func stringSliceDifference(a, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, v := range b {
+		present[v] = true
+	}
+
+	var diff []string
+	for _, v := range a {
+		if !present[v] {
+			diff = append(diff, v)
+		}
+	}
+
+	return diff
+}
+
+func expandAclEntries(tfSet *schema.Set) []aclEntry {
+	entries := make([]aclEntry, 0, tfSet.Len())
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		entries = append(entries, aclEntry{
+			Principal:        tfMap["principal"].(string),
+			Permissions:      expandStringSet(tfMap["permissions"].(*schema.Set)),
+			GrantPermissions: expandStringSet(tfMap["permissions_with_grant_option"].(*schema.Set)),
+		})
+	}
+
+	return entries
+}
+
+func expandStringSet(s *schema.Set) []string {
+	out := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+func flattenAclEntries(allPermissions []*lakeformation.PrincipalResourcePermissions) []interface{} {
+	byPrincipal := make(map[string]*aclEntry)
+	var order []string
+
+	for _, perm := range allPermissions {
+		principal := aws.StringValue(perm.Principal.DataLakePrincipalIdentifier)
+
+		e, ok := byPrincipal[principal]
+		if !ok {
+			e = &aclEntry{Principal: principal}
+			byPrincipal[principal] = e
+			order = append(order, principal)
+		}
+
+		e.Permissions = append(e.Permissions, aws.StringValueSlice(perm.Permissions)...)
+		e.GrantPermissions = append(e.GrantPermissions, aws.StringValueSlice(perm.PermissionsWithGrantOption)...)
+	}
+
+	out := make([]interface{}, 0, len(order))
+	for _, principal := range order {
+		e := byPrincipal[principal]
+		out = append(out, map[string]interface{}{
+			"principal":                     e.Principal,
+			"permissions":                   e.Permissions,
+			"permissions_with_grant_option": e.GrantPermissions,
+		})
+	}
+
+	return out
+}
+
+func expandPermissionsV2Resource(d *schema.ResourceData) (*lakeformation.Resource, string, error) {
+	resource := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(l["name"].(string)),
+		}
+		return resource, lakeformation.ResourceTypeDatabase, nil
+	}
+
+	if v, ok := d.GetOk("table"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		table := &lakeformation.TableResource{
+			DatabaseName: aws.String(l["database_name"].(string)),
+		}
+		if name, ok := l["name"].(string); ok && name != "" {
+			table.Name = aws.String(name)
+		}
+		if wildcard, ok := l["wildcard"].(bool); ok && wildcard {
+			table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+		resource.Table = table
+		return resource, lakeformation.ResourceTypeTable, nil
+	}
+
+	if v, ok := d.GetOk("lf_tag_policy"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resourceType := l["resource_type"].(string)
+
+		var expression []*lakeformation.LFTag
+		for _, raw := range l["expression"].(*schema.Set).List() {
+			m := raw.(map[string]interface{})
+			expression = append(expression, &lakeformation.LFTag{
+				TagKey:    aws.String(m["key"].(string)),
+				TagValues: aws.StringSlice(expandStringSet(m["values"].(*schema.Set))),
+			})
+		}
+
+		resource.LFTagPolicy = &lakeformation.LFTagPolicyResource{
+			ResourceType: aws.String(resourceType),
+			Expression:   expression,
+		}
+		return resource, "LF_TAG_POLICY", nil
+	}
+
+	return nil, "", fmt.Errorf("one of `database`, `table`, or `lf_tag_policy` must be set")
+}
+
+// permissionsV2ID builds a stable composite ID of `<resource-type>/<encoded-resource>` so
+// multiple principals sharing the same resource don't need to be encoded into the Terraform
+// resource ID. Unlike a hash, the encoded resource is reversible, which is what lets
+// resourceAwsLakeFormationPermissionsV2Import repopulate database/table/lf_tag_policy on import.
+func permissionsV2ID(resourceType string, resource *lakeformation.Resource) string {
+	body, _ := json.Marshal(resource)
+	return fmt.Sprintf("%s/%s", resourceType, base64.RawURLEncoding.EncodeToString(body))
+}
+
+// decodePermissionsV2ID reverses permissionsV2ID, returning the resource type and the
+// lakeformation.Resource encoded in id.
+func decodePermissionsV2ID(id string) (string, *lakeformation.Resource, error) {
+	resourceType, encoded, found := strings.Cut(id, "/")
+	if !found {
+		return "", nil, fmt.Errorf("unexpected format of ID (%q), expected <resource-type>/<encoded-resource>", id)
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, fmt.Errorf("error decoding Lake Formation Permissions V2 ID (%s): %w", id, err)
+	}
+
+	resource := &lakeformation.Resource{}
+	if err := json.Unmarshal(body, resource); err != nil {
+		return "", nil, fmt.Errorf("error decoding Lake Formation Permissions V2 ID (%s): %w", id, err)
+	}
+
+	return resourceType, resource, nil
+}
+
+// flattenPermissionsV2Resource is decodePermissionsV2ID's counterpart to expandPermissionsV2Resource:
+// it sets the database/table/lf_tag_policy block matching resourceType/resource so a subsequent
+// Read's call to expandPermissionsV2Resource reconstructs the same lakeformation.Resource.
+func flattenPermissionsV2Resource(d *schema.ResourceData, resourceType string, resource *lakeformation.Resource) error {
+	switch resourceType {
+	case lakeformation.ResourceTypeDatabase:
+		if resource.Database == nil {
+			return fmt.Errorf("ID encodes a %s resource with no Database", resourceType)
+		}
+
+		return d.Set("database", []interface{}{
+			map[string]interface{}{
+				"name": aws.StringValue(resource.Database.Name),
+			},
+		})
+	case lakeformation.ResourceTypeTable:
+		if resource.Table == nil {
+			return fmt.Errorf("ID encodes a %s resource with no Table", resourceType)
+		}
+
+		return d.Set("table", []interface{}{
+			map[string]interface{}{
+				"database_name": aws.StringValue(resource.Table.DatabaseName),
+				"name":          aws.StringValue(resource.Table.Name),
+				"wildcard":      resource.Table.TableWildcard != nil,
+			},
+		})
+	case "LF_TAG_POLICY":
+		if resource.LFTagPolicy == nil {
+			return fmt.Errorf("ID encodes a %s resource with no LFTagPolicy", resourceType)
+		}
+
+		expression := make([]interface{}, 0, len(resource.LFTagPolicy.Expression))
+		for _, tag := range resource.LFTagPolicy.Expression {
+			expression = append(expression, map[string]interface{}{
+				"key":    aws.StringValue(tag.TagKey),
+				"values": aws.StringValueSlice(tag.TagValues),
+			})
+		}
+
+		return d.Set("lf_tag_policy", []interface{}{
+			map[string]interface{}{
+				"resource_type": aws.StringValue(resource.LFTagPolicy.ResourceType),
+				"expression":    expression,
+			},
+		})
+	default:
+		return fmt.Errorf("ID encodes unknown Lake Formation Permissions V2 resource type %q", resourceType)
+	}
+}
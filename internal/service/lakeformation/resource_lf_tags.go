@@ -0,0 +1,301 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
+)
+
+// ResourceResourceLFTags associates LF-Tags (created via aws_lakeformation_lf_tag) with a
+// database, table, or set of table columns.
+func ResourceResourceLFTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationResourceLFTagsCreate,
+		Read:   resourceAwsLakeFormationResourceLFTagsRead,
+		Delete: resourceAwsLakeFormationResourceLFTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationResourceLFTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, err := expandLFTagResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.AddLFTagsToResourceInput{
+		Resource: resource,
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.AddLFTagsToResource(input)
+	if err != nil {
+		return fmt.Errorf("error associating Lake Formation LF-Tags with resource: %w", err)
+	}
+
+	if len(output.Failures) > 0 {
+		return fmt.Errorf("error associating Lake Formation LF-Tags with resource: %s", output.Failures[0].Error.ErrorMessage)
+	}
+
+	d.SetId(resourceLFTagsID(input.CatalogId, resource))
+
+	return resourceAwsLakeFormationResourceLFTagsRead(d, meta)
+}
+
+func resourceAwsLakeFormationResourceLFTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, err := expandLFTagResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           resource,
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.GetResourceLFTags(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	var tags []*lakeformation.LFTagPair
+	tags = append(tags, output.LFTagOnDatabase...)
+	tags = append(tags, output.LFTagsOnTable...)
+	for _, col := range output.LFTagsOnColumns {
+		tags = append(tags, col.LFTags...)
+	}
+
+	if err := d.Set("lf_tag", flattenLFTagPairs(tags)); err != nil {
+		return fmt.Errorf("error setting lf_tag: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationResourceLFTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*client.AWSClient).LakeFormationConn
+
+	resource, err := expandLFTagResource(d)
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.RemoveLFTagsFromResourceInput{
+		Resource: resource,
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	_, err = conn.RemoveLFTagsFromResource(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Lake Formation LF-Tags from resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLFTagResource(d *schema.ResourceData) (*lakeformation.Resource, error) {
+	resource := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(l["name"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("table"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		table := &lakeformation.TableResource{
+			DatabaseName: aws.String(l["database_name"].(string)),
+		}
+		if name, ok := l["name"].(string); ok && name != "" {
+			table.Name = aws.String(name)
+		}
+		if wildcard, ok := l["wildcard"].(bool); ok && wildcard {
+			table.TableWildcard = &lakeformation.TableWildcard{}
+		}
+		resource.Table = table
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		resource.TableWithColumns = &lakeformation.TableWithColumnsResource{
+			DatabaseName: aws.String(l["database_name"].(string)),
+			Name:         aws.String(l["name"].(string)),
+			ColumnNames:  flex.ExpandStringSet(l["column_names"].(*schema.Set)),
+		}
+	}
+
+	if resource.Database == nil && resource.Table == nil && resource.TableWithColumns == nil {
+		return nil, fmt.Errorf("one of `database`, `table`, or `table_with_columns` must be set")
+	}
+
+	return resource, nil
+}
+
+func expandLFTagPairs(tfSet *schema.Set) []*lakeformation.LFTagPair {
+	pairs := make([]*lakeformation.LFTagPair, 0, tfSet.Len())
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		pairs = append(pairs, &lakeformation.LFTagPair{
+			TagKey:    aws.String(tfMap["key"].(string)),
+			TagValues: aws.StringSlice([]string{tfMap["value"].(string)}),
+		})
+	}
+
+	return pairs
+}
+
+func flattenLFTagPairs(tags []*lakeformation.LFTagPair) []interface{} {
+	out := make([]interface{}, 0, len(tags))
+
+	for _, tag := range tags {
+		for _, value := range tag.TagValues {
+			out = append(out, map[string]interface{}{
+				"key":   aws.StringValue(tag.TagKey),
+				"value": aws.StringValue(value),
+			})
+		}
+	}
+
+	return out
+}
+
+func resourceLFTagsID(catalogID *string, resource *lakeformation.Resource) string {
+	switch {
+	case resource.TableWithColumns != nil:
+		return fmt.Sprintf("%s:twc:%s:%s", aws.StringValue(catalogID), aws.StringValue(resource.TableWithColumns.DatabaseName), aws.StringValue(resource.TableWithColumns.Name))
+	case resource.Table != nil:
+		return fmt.Sprintf("%s:table:%s:%s", aws.StringValue(catalogID), aws.StringValue(resource.Table.DatabaseName), aws.StringValue(resource.Table.Name))
+	default:
+		return fmt.Sprintf("%s:database:%s", aws.StringValue(catalogID), aws.StringValue(resource.Database.Name))
+	}
+}
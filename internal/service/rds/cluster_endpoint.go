@@ -1,22 +1,34 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/internal/client"
 	"github.com/terraform-providers/terraform-provider-aws/internal/flex"
 	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/reconcile"
 	"github.com/terraform-providers/terraform-provider-aws/internal/tags"
+	"github.com/terraform-providers/terraform-provider-aws/internal/tfresource"
 )
 
+// clusterEndpointReconciler is aws_rds_cluster_endpoint's entry point into the reconcile package --
+// see that package's doc comment for why Create/Update below dispatch through it.
+var clusterEndpointReconciler = reconcile.NewReconciler(ResourceClusterEndpoint())
+
 const (
 	AWSRDSClusterEndpointCreateTimeout   = 30 * time.Minute
 	AWSRDSClusterEndpointRetryDelay      = 5 * time.Second
@@ -61,30 +73,114 @@ func ResourceClusterEndpoint() *schema.Resource {
 			"excluded_members": {
 				Type:          schema.TypeSet,
 				Optional:      true,
-				ConflictsWith: []string{"static_members"},
+				ConflictsWith: []string{"static_members", "member_selector"},
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Set:           schema.HashString,
 			},
 			"static_members": {
 				Type:          schema.TypeSet,
 				Optional:      true,
-				ConflictsWith: []string{"excluded_members"},
+				ConflictsWith: []string{"excluded_members", "member_selector"},
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Set:           schema.HashString,
 			},
+			"member_selector": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"static_members", "excluded_members"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"writer_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"promotion_tier_gte": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"settle_wait": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDuration,
+						},
+					},
+				},
+			},
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"global_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"secondary_region_endpoints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			// regional_endpoints is the region => {arn, endpoint, status} map described by
+			// global_cluster_identifier/secondary_region_endpoints, represented as a list of
+			// objects keyed by "region" since the plugin SDK's TypeMap can't nest object values.
+			"regional_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags":     tags.TagsSchema(),
 			"tags_all": tags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: tags.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			tags.SetTagsDiff,
+			resourceAwsRDSClusterEndpointMemberSelectorCustomizeDiff,
+		),
 	}
 }
 
 func resourceAwsRDSClusterEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	if reconcile.Enabled() && !clusterEndpointReconciler.InProgress() {
+		return reconcile.ApplyViaReconciler(context.Background(), clusterEndpointReconciler, d, meta)
+	}
+
 	conn := meta.(*client.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -107,6 +203,17 @@ func resourceAwsRDSClusterEndpointCreate(d *schema.ResourceData, meta interface{
 		createClusterEndpointInput.ExcludedMembers = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	var settleWait time.Duration
+	if v, ok := d.GetOk("member_selector"); ok {
+		_, hasPromotionTier := d.GetOkExists("member_selector.0.promotion_tier_gte")
+		members, wait, err := resolveMemberSelector(conn, clusterId, v.([]interface{}), hasPromotionTier)
+		if err != nil {
+			return fmt.Errorf("error resolving member_selector: %w", err)
+		}
+		createClusterEndpointInput.StaticMembers = aws.StringSlice(members)
+		settleWait = wait
+	}
+
 	_, err := conn.CreateDBClusterEndpoint(createClusterEndpointInput)
 	if err != nil {
 		return fmt.Errorf("Error creating RDS Cluster Endpoint: %s", err)
@@ -119,6 +226,15 @@ func resourceAwsRDSClusterEndpointCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if settleWait > 0 {
+		log.Printf("[DEBUG] Waiting %s for RDS Cluster Endpoint %s member_selector to settle", settleWait, d.Id())
+		time.Sleep(settleWait)
+	}
+
+	if err := resourceAwsRDSClusterEndpointUpsertRegionalEndpoints(d, meta, endpointId, endpointType); err != nil {
+		return err
+	}
+
 	return resourceAwsRDSClusterEndpointRead(d, meta)
 }
 
@@ -187,10 +303,18 @@ func resourceAwsRDSClusterEndpointRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
+	if err := resourceAwsRDSClusterEndpointReadRegionalEndpoints(d, meta); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceAwsRDSClusterEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	if reconcile.Enabled() && !clusterEndpointReconciler.InProgress() {
+		return reconcile.ApplyViaReconciler(context.Background(), clusterEndpointReconciler, d, meta)
+	}
+
 	conn := meta.(*client.AWSClient).RDSConn
 	input := &rds.ModifyDBClusterEndpointInput{
 		DBClusterEndpointIdentifier: aws.String(d.Id()),
@@ -208,16 +332,28 @@ func resourceAwsRDSClusterEndpointUpdate(d *schema.ResourceData, meta interface{
 		input.EndpointType = aws.String(v.(string))
 	}
 
-	if attr := d.Get("excluded_members").(*schema.Set); attr.Len() > 0 {
-		input.ExcludedMembers = flex.ExpandStringSet(attr)
-	} else {
+	var settleWait time.Duration
+	if v, ok := d.GetOk("member_selector"); ok {
+		_, hasPromotionTier := d.GetOkExists("member_selector.0.promotion_tier_gte")
+		members, wait, err := resolveMemberSelector(conn, d.Get("cluster_identifier").(string), v.([]interface{}), hasPromotionTier)
+		if err != nil {
+			return fmt.Errorf("error resolving member_selector: %w", err)
+		}
+		input.StaticMembers = aws.StringSlice(members)
 		input.ExcludedMembers = make([]*string, 0)
-	}
-
-	if attr := d.Get("static_members").(*schema.Set); attr.Len() > 0 {
-		input.StaticMembers = flex.ExpandStringSet(attr)
+		settleWait = wait
 	} else {
-		input.StaticMembers = make([]*string, 0)
+		if attr := d.Get("excluded_members").(*schema.Set); attr.Len() > 0 {
+			input.ExcludedMembers = flex.ExpandStringSet(attr)
+		} else {
+			input.ExcludedMembers = make([]*string, 0)
+		}
+
+		if attr := d.Get("static_members").(*schema.Set); attr.Len() > 0 {
+			input.StaticMembers = flex.ExpandStringSet(attr)
+		} else {
+			input.StaticMembers = make([]*string, 0)
+		}
 	}
 
 	_, err := conn.ModifyDBClusterEndpoint(input)
@@ -225,6 +361,16 @@ func resourceAwsRDSClusterEndpointUpdate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error modifying RDS Cluster Endpoint: %s", err)
 	}
 
+	if settleWait > 0 {
+		log.Printf("[DEBUG] Waiting %s for RDS Cluster Endpoint %s member_selector to settle", settleWait, d.Id())
+		time.Sleep(settleWait)
+	}
+
+	endpointType := d.Get("custom_endpoint_type").(string)
+	if err := resourceAwsRDSClusterEndpointUpsertRegionalEndpoints(d, meta, d.Id(), endpointType); err != nil {
+		return err
+	}
+
 	return resourceAwsRDSClusterEndpointRead(d, meta)
 }
 
@@ -238,24 +384,29 @@ func resourceAwsRDSClusterEndpointDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error deleting RDS Cluster Endpoint: %s", err)
 	}
 
+	var result *multierror.Error
 	if err := resourceAwsRDSClusterEndpointWaitForDestroy(d.Timeout(schema.TimeoutDelete), d.Id(), conn); err != nil {
-		return err
+		result = multierror.Append(result, err)
 	}
 
-	return nil
+	if err := resourceAwsRDSClusterEndpointDeleteRegionalEndpoints(d, meta); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
 }
 
 func resourceAwsRDSClusterEndpointWaitForDestroy(timeout time.Duration, id string, conn *rds.RDS) error {
 	log.Printf("Waiting for RDS Cluster Endpoint %s to be deleted...", id)
-	stateConf := &resource.StateChangeConf{
+
+	_, err := tfresource.WaitUntil(context.Background(), tfresource.WaiterConfig{
 		Pending:    []string{"available", "deleting"},
 		Target:     []string{"destroyed"},
 		Refresh:    DBClusterEndpointStateRefreshFunc(conn, id),
 		Timeout:    timeout,
 		Delay:      AWSRDSClusterEndpointRetryDelay,
 		MinTimeout: AWSRDSClusterEndpointRetryMinTimeout,
-	}
-	_, err := stateConf.WaitForState()
+	})
 	if err != nil {
 		return fmt.Errorf("Error waiting for RDS Cluster Endpoint (%s) to be deleted: %v", id, err)
 	}
@@ -265,44 +416,354 @@ func resourceAwsRDSClusterEndpointWaitForDestroy(timeout time.Duration, id strin
 func resourceAwsRDSClusterEndpointWaitForAvailable(timeout time.Duration, id string, conn *rds.RDS) error {
 	log.Printf("Waiting for RDS Cluster Endpoint %s to become available...", id)
 
-	stateConf := &resource.StateChangeConf{
+	_, err := tfresource.WaitUntil(context.Background(), tfresource.WaiterConfig{
 		Pending:    []string{"creating"},
 		Target:     []string{"available"},
 		Refresh:    DBClusterEndpointStateRefreshFunc(conn, id),
 		Timeout:    timeout,
 		Delay:      AWSRDSClusterEndpointRetryDelay,
 		MinTimeout: AWSRDSClusterEndpointRetryMinTimeout,
-	}
-
-	_, err := stateConf.WaitForState()
+	})
 	if err != nil {
 		return fmt.Errorf("Error waiting for RDS Cluster Endpoint (%s) to be ready: %v", id, err)
 	}
 	return nil
 }
 
+// DBClusterEndpointStateRefreshFunc describes the DB cluster endpoint and maps the API's own
+// "destroyed" non-state (the endpoint, or its whole cluster, is simply gone) onto the refresh
+// func's terminal state via tfresource.RefreshFuncFromAPI.
 func DBClusterEndpointStateRefreshFunc(conn *rds.RDS, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		emptyResp := &rds.DescribeDBClusterEndpointsOutput{}
-
+	return tfresource.RefreshFuncFromAPI(func() (interface{}, error) {
 		resp, err := conn.DescribeDBClusterEndpoints(
 			&rds.DescribeDBClusterEndpointsInput{
 				DBClusterEndpointIdentifier: aws.String(id),
 			})
 		if err != nil {
-			if tfawserr.ErrMessageContains(err, rds.ErrCodeDBClusterNotFoundFault, "") {
-				return emptyResp, "destroyed", nil
-			} else if resp != nil && len(resp.DBClusterEndpoints) == 0 {
-				return emptyResp, "destroyed", nil
-			} else {
-				return emptyResp, "", fmt.Errorf("Error on refresh: %+v", err)
+			return nil, err
+		}
+
+		if len(resp.DBClusterEndpoints) == 0 {
+			return nil, nil
+		}
+
+		return resp.DBClusterEndpoints[0], nil
+	}, func(v interface{}) string {
+		return aws.StringValue(v.(*rds.DBClusterEndpoint).Status)
+	}, "destroyed", rds.ErrCodeDBClusterNotFoundFault)
+}
+
+// resolveMemberSelector looks up the cluster's current topology (writer/promotion tier come from
+// DescribeDBClusters' member list; tags come from DescribeDBInstances filtered by db-cluster-id),
+// evaluates the member_selector block against them, and returns the matching instance identifiers
+// sorted for a deterministic plan/apply, plus the configured settle_wait duration, if any.
+//
+// hasPromotionTier must come from the caller's own d.GetOkExists("member_selector.0.promotion_tier_gte")
+// rather than being derived from l: promotion_tier_gte is a TypeInt, so l's map always carries it with
+// its Go zero value (0) whether or not the user actually configured it, making "0" and "unset"
+// indistinguishable from inside this func.
+func resolveMemberSelector(conn *rds.RDS, clusterID string, l []interface{}, hasPromotionTier bool) ([]string, time.Duration, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, 0, nil
+	}
+	m := l[0].(map[string]interface{})
+
+	clusterOutput, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error describing DB Cluster (%s): %w", clusterID, err)
+	}
+	if clusterOutput == nil || len(clusterOutput.DBClusters) == 0 {
+		return nil, 0, fmt.Errorf("DB Cluster (%s) not found", clusterID)
+	}
+
+	instancesOutput, err := conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: []*string{aws.String(clusterID)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error describing DB Instances for cluster (%s): %w", clusterID, err)
+	}
+	instanceTags := make(map[string]map[string]string, len(instancesOutput.DBInstances))
+	for _, instance := range instancesOutput.DBInstances {
+		tagMap := make(map[string]string, len(instance.TagList))
+		for _, t := range instance.TagList {
+			tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+		instanceTags[aws.StringValue(instance.DBInstanceIdentifier)] = tagMap
+	}
+
+	wantTags := map[string]string{}
+	if v, ok := m["tag"].(*schema.Set); ok {
+		for _, raw := range v.List() {
+			tfMap := raw.(map[string]interface{})
+			wantTags[tfMap["key"].(string)] = tfMap["value"].(string)
+		}
+	}
+
+	writerOnly, _ := m["writer_only"].(bool)
+	promotionTierGte, _ := m["promotion_tier_gte"].(int)
+
+	var members []string
+	for _, member := range clusterOutput.DBClusters[0].DBClusterMembers {
+		id := aws.StringValue(member.DBInstanceIdentifier)
+
+		if writerOnly && !aws.BoolValue(member.IsClusterWriter) {
+			continue
+		}
+
+		if hasPromotionTier && aws.Int64Value(member.PromotionTier) < int64(promotionTierGte) {
+			continue
+		}
+
+		if len(wantTags) > 0 && !tagsMatch(instanceTags[id], wantTags) {
+			continue
+		}
+
+		members = append(members, id)
+	}
+
+	sort.Strings(members)
+
+	var settleWait time.Duration
+	if v, ok := m["settle_wait"].(string); ok && v != "" {
+		settleWait, _ = time.ParseDuration(v)
+	}
+
+	return members, settleWait, nil
+}
+
+// resourceAwsRDSClusterEndpointMemberSelectorCustomizeDiff re-resolves member_selector at plan time
+// and, if the tags/promotion tiers/writer status it now matches differ from the static_members
+// already in state, overrides the planned static_members diff to match -- otherwise a plan run
+// between applies would never notice that member_selector now matches a different set of instances,
+// since member_selector's own config value hasn't changed.
+func resourceAwsRDSClusterEndpointMemberSelectorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("member_selector")
+	if !ok {
+		return nil
+	}
+
+	conn := meta.(*client.AWSClient).RDSConn
+	_, hasPromotionTier := d.GetOkExists("member_selector.0.promotion_tier_gte")
+
+	members, _, err := resolveMemberSelector(conn, d.Get("cluster_identifier").(string), v.([]interface{}), hasPromotionTier)
+	if err != nil {
+		// The cluster (or its instances) may not exist yet, e.g. both are being created in the same
+		// apply. Let Create surface the real error instead of failing the plan here.
+		return nil
+	}
+
+	current := d.Get("static_members").(*schema.Set)
+	resolved := schema.NewSet(schema.HashString, flex.FlattenStringList(aws.StringSlice(members)))
+	if len(current.Difference(resolved).List()) > 0 || len(resolved.Difference(current).List()) > 0 {
+		if err := d.SetNew("static_members", resolved); err != nil {
+			return fmt.Errorf("error setting static_members diff from member_selector: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func tagsMatch(have, want map[string]string) bool {
+	for k, v := range want {
+		if have[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// validateDuration is the ValidateFunc for member_selector.settle_wait, which is parsed with
+// time.ParseDuration rather than a regexp since Go's duration grammar isn't simply expressed as one.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %w", k, err))
+	}
+	return
+}
+
+// regionAndClusterIdentifierFromClusterArn extracts the region and unqualified cluster identifier
+// from a DB cluster ARN, e.g. "arn:aws:rds:us-west-2:123456789012:cluster:mydbcluster".
+func regionAndClusterIdentifierFromClusterArn(clusterArn string) (region string, clusterIdentifier string, err error) {
+	parsed, err := arn.Parse(clusterArn)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing DB cluster ARN (%s): %w", clusterArn, err)
+	}
+
+	return parsed.Region, strings.TrimPrefix(parsed.Resource, "cluster:"), nil
+}
+
+// globalClusterRegionalMembers describes each region in globalClusterID's Aurora Global Database,
+// keyed by region, along with the region's own (regional) DB cluster identifier.
+func globalClusterRegionalMembers(conn *rds.RDS, globalClusterID string) (map[string]string, error) {
+	output, err := conn.DescribeGlobalClusters(&rds.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(globalClusterID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing Global Cluster (%s): %w", globalClusterID, err)
+	}
+	if output == nil || len(output.GlobalClusters) == 0 {
+		return nil, fmt.Errorf("Global Cluster (%s) not found", globalClusterID)
+	}
+
+	regionalClusters := make(map[string]string, len(output.GlobalClusters[0].GlobalClusterMembers))
+	for _, member := range output.GlobalClusters[0].GlobalClusterMembers {
+		region, clusterIdentifier, err := regionAndClusterIdentifierFromClusterArn(aws.StringValue(member.DBClusterArn))
+		if err != nil {
+			return nil, err
+		}
+		regionalClusters[region] = clusterIdentifier
+	}
+
+	return regionalClusters, nil
+}
+
+// resourceAwsRDSClusterEndpointUpsertRegionalEndpoints fans a matching custom endpoint (same
+// endpointId/endpointType/member policy) out to each of d's secondary_region_endpoints, resolving
+// each region's own DBConn via client.AWSClient.RDSConnForRegion and its own regional cluster
+// identifier via the global cluster's member list, so member_selector is evaluated against that
+// region's own topology rather than the primary region's.
+func resourceAwsRDSClusterEndpointUpsertRegionalEndpoints(d *schema.ResourceData, meta interface{}, endpointId, endpointType string) error {
+	secondaryRegions := d.Get("secondary_region_endpoints").(*schema.Set)
+	if secondaryRegions.Len() == 0 {
+		return nil
+	}
+
+	globalClusterID, ok := d.GetOk("global_cluster_identifier")
+	if !ok {
+		return fmt.Errorf("secondary_region_endpoints requires global_cluster_identifier to be set")
+	}
+
+	conn := meta.(*client.AWSClient).RDSConn
+	regionalClusters, err := globalClusterRegionalMembers(conn, globalClusterID.(string))
+	if err != nil {
+		return err
+	}
+
+	memberSelector, _ := d.GetOk("member_selector")
+	_, hasPromotionTier := d.GetOkExists("member_selector.0.promotion_tier_gte")
+	staticMembers := d.Get("static_members").(*schema.Set)
+	excludedMembers := d.Get("excluded_members").(*schema.Set)
+
+	for _, v := range secondaryRegions.List() {
+		region := v.(string)
+
+		clusterIdentifier, ok := regionalClusters[region]
+		if !ok {
+			return fmt.Errorf("no regional cluster found for secondary region %q in Global Cluster (%s)", region, globalClusterID)
+		}
+
+		regionalConn := meta.(*client.AWSClient).RDSConnForRegion(region)
+
+		input := &rds.CreateDBClusterEndpointInput{
+			DBClusterIdentifier:         aws.String(clusterIdentifier),
+			DBClusterEndpointIdentifier: aws.String(endpointId),
+			EndpointType:                aws.String(endpointType),
+		}
+
+		if memberSelector != nil {
+			members, _, err := resolveMemberSelector(regionalConn, clusterIdentifier, memberSelector.([]interface{}), hasPromotionTier)
+			if err != nil {
+				return fmt.Errorf("error resolving member_selector for region %q: %w", region, err)
 			}
+			input.StaticMembers = aws.StringSlice(members)
+		} else {
+			input.StaticMembers = flex.ExpandStringSet(staticMembers)
+			input.ExcludedMembers = flex.ExpandStringSet(excludedMembers)
 		}
 
-		if resp == nil || resp.DBClusterEndpoints == nil || len(resp.DBClusterEndpoints) == 0 {
-			return emptyResp, "destroyed", nil
+		if _, err := regionalConn.CreateDBClusterEndpoint(input); err != nil {
+			if !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterEndpointAlreadyExistsFault) {
+				return fmt.Errorf("error creating RDS Cluster Endpoint in region %q: %w", region, err)
+			}
+
+			if _, err := regionalConn.ModifyDBClusterEndpoint(&rds.ModifyDBClusterEndpointInput{
+				DBClusterEndpointIdentifier: aws.String(endpointId),
+				EndpointType:                aws.String(endpointType),
+				StaticMembers:               input.StaticMembers,
+				ExcludedMembers:             input.ExcludedMembers,
+			}); err != nil {
+				return fmt.Errorf("error modifying RDS Cluster Endpoint in region %q: %w", region, err)
+			}
+			continue
 		}
 
-		return resp.DBClusterEndpoints[0], *resp.DBClusterEndpoints[0].Status, nil
+		if err := resourceAwsRDSClusterEndpointWaitForAvailable(AWSRDSClusterEndpointCreateTimeout, endpointId, regionalConn); err != nil {
+			return fmt.Errorf("error waiting for RDS Cluster Endpoint in region %q: %w", region, err)
+		}
 	}
+
+	return nil
+}
+
+// resourceAwsRDSClusterEndpointReadRegionalEndpoints populates the regional_endpoints computed
+// attribute by describing the endpoint in every configured secondary region.
+func resourceAwsRDSClusterEndpointReadRegionalEndpoints(d *schema.ResourceData, meta interface{}) error {
+	secondaryRegions := d.Get("secondary_region_endpoints").(*schema.Set)
+	if secondaryRegions.Len() == 0 {
+		return d.Set("regional_endpoints", nil)
+	}
+
+	var regionalEndpoints []interface{}
+	for _, v := range secondaryRegions.List() {
+		region := v.(string)
+		regionalConn := meta.(*client.AWSClient).RDSConnForRegion(region)
+
+		output, err := regionalConn.DescribeDBClusterEndpoints(&rds.DescribeDBClusterEndpointsInput{
+			DBClusterEndpointIdentifier: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error describing RDS Cluster Endpoint in region %q: %w", region, err)
+		}
+		if len(output.DBClusterEndpoints) == 0 {
+			continue
+		}
+
+		ep := output.DBClusterEndpoints[0]
+		regionalEndpoints = append(regionalEndpoints, map[string]interface{}{
+			"region":   region,
+			"arn":      aws.StringValue(ep.DBClusterEndpointArn),
+			"endpoint": aws.StringValue(ep.Endpoint),
+			"status":   aws.StringValue(ep.Status),
+		})
+	}
+
+	return d.Set("regional_endpoints", regionalEndpoints)
+}
+
+// resourceAwsRDSClusterEndpointDeleteRegionalEndpoints tears down the endpoint in every configured
+// secondary region, accumulating failures instead of stopping at the first so a partial failure
+// doesn't strand the remaining regional endpoints undeleted.
+func resourceAwsRDSClusterEndpointDeleteRegionalEndpoints(d *schema.ResourceData, meta interface{}) error {
+	secondaryRegions := d.Get("secondary_region_endpoints").(*schema.Set)
+	if secondaryRegions.Len() == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+	for _, v := range secondaryRegions.List() {
+		region := v.(string)
+		regionalConn := meta.(*client.AWSClient).RDSConnForRegion(region)
+
+		_, err := regionalConn.DeleteDBClusterEndpoint(&rds.DeleteDBClusterEndpointInput{
+			DBClusterEndpointIdentifier: aws.String(d.Id()),
+		})
+		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterEndpointNotFoundFault) {
+			result = multierror.Append(result, fmt.Errorf("error deleting RDS Cluster Endpoint in region %q: %w", region, err))
+			continue
+		}
+
+		if err := resourceAwsRDSClusterEndpointWaitForDestroy(AWSRDSClusterEndpointCreateTimeout, d.Id(), regionalConn); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error waiting for RDS Cluster Endpoint deletion in region %q: %w", region, err))
+		}
+	}
+
+	return result.ErrorOrNil()
 }
\ No newline at end of file
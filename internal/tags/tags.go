@@ -0,0 +1,58 @@
+// Package tags wires keyvaluetags.KeyValueTags into the schema.Resource
+// plumbing shared by every taggable resource: the "tags"/"tags_all" schema
+// pair and the CustomizeDiff that keeps tags_all in sync with the
+// provider-level default_tags.
+//
+// DefaultConfig and IgnoreConfig are defined in keyvaluetags (KeyValueTags
+// methods like RemoveDefaultConfig/IgnoreConfig need to accept them); they
+// are aliased here so resource code can talk to this package alone for both
+// the schema helpers and the provider-level config types.
+package tags
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/internal/client"
+	"github.com/terraform-providers/terraform-provider-aws/internal/keyvaluetags"
+)
+
+// DefaultConfig represents the provider-level default_tags configuration.
+type DefaultConfig = keyvaluetags.DefaultConfig
+
+// IgnoreConfig represents the provider-level ignore_tags configuration.
+type IgnoreConfig = keyvaluetags.IgnoreConfig
+
+// TagsSchema returns the standard "tags" schema for a taggable resource.
+func TagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// TagsSchemaComputed returns the standard "tags_all" schema: the resource's
+// own tags merged with the provider-level default_tags.
+func TagsSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// SetTagsDiff is a CustomizeDiff function that sets a resource's computed
+// "tags_all" diff to the merge of its configured "tags" and the provider's
+// default_tags, so plans show the tags a resource will actually have.
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTagsConfig := meta.(*client.AWSClient).DefaultTagsConfig
+	rawTags := diff.Get("tags").(map[string]interface{})
+	allTags := defaultTagsConfig.MergeTags(keyvaluetags.New(rawTags)).IgnoreAws()
+
+	if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package tfresource
+
+import "errors"
+
+// NotFoundError indicates that a find/describe helper successfully called the API but located zero
+// matching results, distinct from a transport, permissions, or other unexpected error that callers
+// should not silently translate into "the resource is gone".
+type NotFoundError struct {
+	LastError   error
+	LastRequest interface{}
+}
+
+func (e *NotFoundError) Error() string {
+	if e.LastError != nil {
+		return e.LastError.Error()
+	}
+
+	return "couldn't find resource"
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.LastError
+}
+
+// NewNotFoundError wraps err (if any) and the request that produced it, so find helpers have a
+// single, inspectable error to return instead of each hand-rolling a "no results" sentinel.
+func NewNotFoundError(err error, lastRequest interface{}) *NotFoundError {
+	return &NotFoundError{
+		LastError:   err,
+		LastRequest: lastRequest,
+	}
+}
+
+// NotFound returns true if err is a *NotFoundError, unwrapping as needed.
+func NotFound(err error) bool {
+	var notFoundError *NotFoundError
+	return errors.As(err, &notFoundError)
+}
@@ -0,0 +1,54 @@
+package tfresource
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// RetryMatcher describes one (code, messageSubstring) pair that RetryWhenAwsErrCodeMatches treats
+// as retryable. An empty MessageSubstring matches on code alone, mirroring tfawserr.ErrMessageContains.
+type RetryMatcher struct {
+	Code             string
+	MessageSubstring string
+}
+
+// RetryWhenAwsErrCodeMatches retries f until it stops returning an awserr.Error whose code and
+// (optional) message substring match one of matchers, or until timeout elapses. It generalizes
+// RetryWhenAwsErrCodeEquals to the eventually-consistent errors that aren't a simple single-code
+// retry: IAM/KMS role propagation lag right after a dependency is created, generic throttling, and
+// similar transient InvalidParameterValueException conditions. The final error is returned
+// unwrapped so callers can still type-assert on it for state handling.
+func RetryWhenAwsErrCodeMatches(timeout time.Duration, f func() (interface{}, error), matchers ...RetryMatcher) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		output, err = f()
+
+		if err == nil {
+			return nil
+		}
+
+		if awsErr, ok := err.(awserr.Error); ok {
+			for _, m := range matchers {
+				if awsErr.Code() != m.Code {
+					continue
+				}
+				if m.MessageSubstring == "" || strings.Contains(awsErr.Message(), m.MessageSubstring) {
+					return resource.RetryableError(err)
+				}
+			}
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	if _, ok := err.(*resource.TimeoutError); ok {
+		output, err = f()
+	}
+
+	return output, err
+}
@@ -0,0 +1,68 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRetryWhenAwsErrCodeMatches_retriesThenSucceeds(t *testing.T) {
+	attempts := 0
+	matchers := []RetryMatcher{
+		{Code: "InvalidParameterValueException", MessageSubstring: "is not authorized"},
+		{Code: "Throttling"},
+	}
+
+	output, err := RetryWhenAwsErrCodeMatches(5*time.Second, func() (interface{}, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, awserr.New("InvalidParameterValueException", "arn:aws:iam::123456789012:role/foo is not authorized to perform", nil)
+		}
+		return "ok", nil
+	}, matchers...)
+
+	if err != nil {
+		t.Fatalf("expected no error after retries, got: %s", err)
+	}
+	if output != "ok" {
+		t.Fatalf("expected output %q, got %q", "ok", output)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryWhenAwsErrCodeMatches_nonMatchingErrorIsNotRetried(t *testing.T) {
+	attempts := 0
+
+	_, err := RetryWhenAwsErrCodeMatches(5*time.Second, func() (interface{}, error) {
+		attempts++
+		return nil, awserr.New("ValidationException", "bad input", nil)
+	}, RetryMatcher{Code: "Throttling"})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt for a non-matching error, got %d", attempts)
+	}
+}
+
+func TestRetryWhenAwsErrCodeMatches_nonAwsErrorIsNotRetried(t *testing.T) {
+	attempts := 0
+	wantErr := errors.New("boom")
+
+	_, err := RetryWhenAwsErrCodeMatches(5*time.Second, func() (interface{}, error) {
+		attempts++
+		return nil, wantErr
+	}, RetryMatcher{Code: "Throttling"})
+
+	if err != wantErr {
+		t.Fatalf("expected unwrapped error %v, got %v", wantErr, err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt for a non-AWS error, got %d", attempts)
+	}
+}
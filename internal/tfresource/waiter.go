@@ -0,0 +1,86 @@
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// WaiterConfig is the resource.StateChangeConf fields a caller actually varies, collected so
+// WaitUntil can be reached for instead of every resource hand-building its own StateChangeConf.
+type WaiterConfig struct {
+	Pending                    []string
+	Target                     []string
+	Refresh                    resource.StateRefreshFunc
+	Timeout                    time.Duration
+	Delay                      time.Duration
+	MinTimeout                 time.Duration
+	PollInterval               time.Duration
+	NotFoundChecks             int
+	ContinuousTargetOccurrence int
+}
+
+// WaitUntil polls cfg.Refresh with the tuning knobs in cfg until it reaches a target state, a
+// non-retryable error occurs, or ctx is done -- resource.StateChangeConf has no context parameter
+// of its own, so this is the thin context-aware wrapper callers reach for instead of hand-rolling a
+// goroutine+select around WaitForState.
+func WaitUntil(ctx context.Context, cfg WaiterConfig) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   cfg.Pending,
+		Target:                    cfg.Target,
+		Refresh:                   cfg.Refresh,
+		Timeout:                   cfg.Timeout,
+		Delay:                     cfg.Delay,
+		MinTimeout:                cfg.MinTimeout,
+		PollInterval:              cfg.PollInterval,
+		NotFoundChecks:            cfg.NotFoundChecks,
+		ContinuousTargetOccurence: cfg.ContinuousTargetOccurrence,
+	}
+
+	type result struct {
+		output interface{}
+		err    error
+	}
+
+	resultCh := make(chan result, 1)
+	go func() {
+		output, err := stateConf.WaitForState()
+		resultCh <- result{output, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-resultCh:
+		return r.output, r.err
+	}
+}
+
+// RefreshFuncFromAPI adapts a describe closure and a status extractor into a resource.StateRefreshFunc,
+// translating both a *NotFoundError and any of notFoundErrCodes (checked via tfawserr.ErrCodeEquals)
+// into notFoundState instead of forcing every caller to hand-roll that translation.
+func RefreshFuncFromAPI(describe func() (interface{}, error), status func(interface{}) string, notFoundState string, notFoundErrCodes ...string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := describe()
+
+		if NotFound(err) {
+			return nil, notFoundState, nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, notFoundErrCodes...) {
+			return nil, notFoundState, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, notFoundState, nil
+		}
+
+		return output, status(output), nil
+	}
+}
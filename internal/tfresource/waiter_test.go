@@ -0,0 +1,119 @@
+package tfresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRefreshFuncFromAPI_translatesNotFoundError(t *testing.T) {
+	refresh := RefreshFuncFromAPI(func() (interface{}, error) {
+		return nil, NewNotFoundError(nil, nil)
+	}, func(interface{}) string {
+		t.Fatal("status should not be called for a not-found result")
+		return ""
+	}, "destroyed")
+
+	output, state, err := refresh()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if output != nil {
+		t.Fatalf("expected nil output, got: %v", output)
+	}
+	if state != "destroyed" {
+		t.Fatalf("expected state %q, got %q", "destroyed", state)
+	}
+}
+
+func TestRefreshFuncFromAPI_translatesAwsErrCode(t *testing.T) {
+	refresh := RefreshFuncFromAPI(func() (interface{}, error) {
+		return nil, awserr.New("ResourceNotFoundException", "gone", nil)
+	}, func(interface{}) string {
+		t.Fatal("status should not be called for a not-found result")
+		return ""
+	}, "destroyed", "ResourceNotFoundException")
+
+	_, state, err := refresh()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if state != "destroyed" {
+		t.Fatalf("expected state %q, got %q", "destroyed", state)
+	}
+}
+
+func TestRefreshFuncFromAPI_returnsStatus(t *testing.T) {
+	refresh := RefreshFuncFromAPI(func() (interface{}, error) {
+		return "some-output", nil
+	}, func(v interface{}) string {
+		return v.(string)
+	}, "destroyed")
+
+	output, state, err := refresh()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if output != "some-output" {
+		t.Fatalf("expected output %q, got %v", "some-output", output)
+	}
+	if state != "some-output" {
+		t.Fatalf("expected state %q, got %q", "some-output", state)
+	}
+}
+
+func TestWaitUntil_succeeds(t *testing.T) {
+	attempts := 0
+	refresh := RefreshFuncFromAPI(func() (interface{}, error) {
+		attempts++
+		if attempts < 2 {
+			return "pending-thing", nil
+		}
+		return "ready-thing", nil
+	}, func(v interface{}) string {
+		if v.(string) == "ready-thing" {
+			return "available"
+		}
+		return "creating"
+	}, "destroyed")
+
+	output, err := WaitUntil(context.Background(), WaiterConfig{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    refresh,
+		Timeout:    5 * time.Second,
+		MinTimeout: 10 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if output != "ready-thing" {
+		t.Fatalf("expected output %q, got %v", "ready-thing", output)
+	}
+}
+
+func TestWaitUntil_honorsContextCancellation(t *testing.T) {
+	refresh := RefreshFuncFromAPI(func() (interface{}, error) {
+		return "pending-thing", nil
+	}, func(interface{}) string {
+		return "creating"
+	}, "destroyed")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := WaitUntil(ctx, WaiterConfig{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    refresh,
+		Timeout:    5 * time.Second,
+		MinTimeout: 10 * time.Millisecond,
+	})
+
+	if err != context.Canceled {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}